@@ -0,0 +1,93 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"use-open-workflow.io/engine/internal/port/outbox/inbound"
+)
+
+const (
+	defaultDeadLetterLimit = 50
+	maxDeadLetterLimit     = 500
+)
+
+type OutboxDeadLetterHandler struct {
+	service inbound.OutboxDeadLetterService
+}
+
+func NewOutboxDeadLetterHandler(service inbound.OutboxDeadLetterService) *OutboxDeadLetterHandler {
+	return &OutboxDeadLetterHandler{service: service}
+}
+
+func (h *OutboxDeadLetterHandler) List(c fiber.Ctx) error {
+	limit := fiber.Query(c, "limit", defaultDeadLetterLimit)
+	offset := fiber.Query(c, "offset", 0)
+	if limit < 1 || limit > maxDeadLetterLimit || offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid limit or offset",
+		})
+	}
+
+	deadLetters, err := h.service.List(c.Context(), limit, offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(deadLetters)
+}
+
+func (h *OutboxDeadLetterHandler) GetByID(c fiber.Ctx) error {
+	id := c.Params("id")
+	deadLetter, err := h.service.GetByID(c.Context(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if deadLetter == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dead letter not found",
+		})
+	}
+	return c.JSON(deadLetter)
+}
+
+func (h *OutboxDeadLetterHandler) Requeue(c fiber.Ctx) error {
+	id := c.Params("id")
+	requeued, err := h.service.Requeue(c.Context(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if !requeued {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dead letter not found",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *OutboxDeadLetterHandler) RequeueMany(c fiber.Ctx) error {
+	var input inbound.RequeueOutboxDeadLettersInput
+	if err := c.Bind().JSON(&input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	result, err := h.service.RequeueMany(c.Context(), input)
+	if errors.Is(err, inbound.ErrNoDeadLettersSelected) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(result)
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/gofiber/fiber/v3/middleware/logger"
 	"github.com/gofiber/fiber/v3/middleware/recover"
 	"use-open-workflow.io/engine/api/node/http"
+	outboxHttp "use-open-workflow.io/engine/api/outbox/http"
+	subscriptionHttp "use-open-workflow.io/engine/api/subscription/http"
 	"use-open-workflow.io/engine/di"
 )
 
@@ -18,6 +20,8 @@ func SetupRouter(c *di.Container) *fiber.App {
 
 	api := app.Group("/api/v1")
 	registerNodeTemplateRoutes(api, c)
+	registerSubscriptionRoutes(api, c)
+	registerOutboxRoutes(api, c)
 
 	return app
 }
@@ -35,3 +39,28 @@ func registerNodeTemplateRoutes(router fiber.Router, c *di.Container) {
 	nodeTemplate.Put("/:id", nodeTemplateHandler.Update)
 	nodeTemplate.Delete("/:id", nodeTemplateHandler.Delete)
 }
+
+func registerSubscriptionRoutes(router fiber.Router, c *di.Container) {
+	subscriptionHandler := subscriptionHttp.NewSubscriptionHandler(
+		c.SubscriptionReadService,
+		c.SubscriptionWriteService,
+	)
+
+	subscription := router.Group("/subscription")
+	subscription.Get("/", subscriptionHandler.List)
+	subscription.Get("/:id", subscriptionHandler.GetByID)
+	subscription.Get("/:id/delivery", subscriptionHandler.ListDeliveries)
+	subscription.Post("/", subscriptionHandler.Create)
+	subscription.Put("/:id", subscriptionHandler.Update)
+	subscription.Delete("/:id", subscriptionHandler.Delete)
+}
+
+func registerOutboxRoutes(router fiber.Router, c *di.Container) {
+	deadLetterHandler := outboxHttp.NewOutboxDeadLetterHandler(c.OutboxDeadLetterService)
+
+	deadLetter := router.Group("/outbox/dead-letter")
+	deadLetter.Get("/", deadLetterHandler.List)
+	deadLetter.Post("/requeue", deadLetterHandler.RequeueMany)
+	deadLetter.Get("/:id", deadLetterHandler.GetByID)
+	deadLetter.Post("/:id/requeue", deadLetterHandler.Requeue)
+}
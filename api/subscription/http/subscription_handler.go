@@ -0,0 +1,111 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"use-open-workflow.io/engine/internal/port/subscription/inbound"
+)
+
+type SubscriptionHandler struct {
+	readService  inbound.SubscriptionReadService
+	writeService inbound.SubscriptionWriteService
+}
+
+func NewSubscriptionHandler(
+	readService inbound.SubscriptionReadService,
+	writeService inbound.SubscriptionWriteService,
+) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		readService:  readService,
+		writeService: writeService,
+	}
+}
+
+func (h *SubscriptionHandler) List(c fiber.Ctx) error {
+	subscriptions, err := h.readService.List(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(subscriptions)
+}
+
+func (h *SubscriptionHandler) GetByID(c fiber.Ctx) error {
+	id := c.Params("id")
+	subscription, err := h.readService.GetByID(c.Context(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if subscription == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "subscription not found",
+		})
+	}
+	return c.JSON(subscription)
+}
+
+func (h *SubscriptionHandler) ListDeliveries(c fiber.Ctx) error {
+	id := c.Params("id")
+	deliveries, err := h.readService.ListDeliveries(c.Context(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if deliveries == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "subscription not found",
+		})
+	}
+	return c.JSON(deliveries)
+}
+
+func (h *SubscriptionHandler) Create(c fiber.Ctx) error {
+	var input inbound.CreateSubscriptionInput
+	if err := c.Bind().JSON(&input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	subscription, err := h.writeService.Create(c.Context(), input)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(subscription)
+}
+
+func (h *SubscriptionHandler) Update(c fiber.Ctx) error {
+	id := c.Params("id")
+	var input inbound.UpdateSubscriptionInput
+	if err := c.Bind().JSON(&input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	subscription, err := h.writeService.Update(c.Context(), id, input)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(subscription)
+}
+
+func (h *SubscriptionHandler) Delete(c fiber.Ctx) error {
+	id := c.Params("id")
+	if err := h.writeService.Delete(c.Context(), id); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
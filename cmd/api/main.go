@@ -3,7 +3,6 @@ package main
 import (
 	"context"
 	"log"
-	"os"
 	"os/signal"
 	"syscall"
 
@@ -12,31 +11,40 @@ import (
 )
 
 func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	cfg, err := di.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	c, err := di.NewContainer(ctx)
+	c, err := di.NewContainer(ctx, cfg)
 	if err != nil {
 		log.Fatalf("Failed to initialize container: %v", err)
 	}
 	defer c.Close()
 
-	if err := c.OutboxProcessor.Start(ctx); err != nil {
-		log.Fatalf("Failed to start outbox processor: %v", err)
+	if c.OutboxProcessor != nil {
+		if err := c.OutboxProcessor.Start(ctx); err != nil {
+			log.Fatalf("Failed to start outbox processor: %v", err)
+		}
+	} else {
+		log.Println("Background processing disabled")
 	}
 
 	app := api.SetupRouter(c)
 
 	go func() {
-		sigCh := make(chan os.Signal, 1)
-		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-		<-sigCh
-
+		<-ctx.Done()
 		log.Println("Shutting down...")
-		cancel()
 		app.Shutdown()
 	}()
 
-	log.Println("Starting server on :3000")
-	log.Fatal(app.Listen(":3000"))
+	log.Printf("Starting server on %s", cfg.HTTPAddr)
+	if err := app.Listen(cfg.HTTPAddr); err != nil && ctx.Err() == nil {
+		// log.Fatalf skips deferred calls, so release resources first.
+		c.Close()
+		log.Fatalf("Server failed: %v", err)
+	}
 }
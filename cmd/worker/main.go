@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"use-open-workflow.io/engine/di"
+)
+
+func main() {
+	cfg, err := di.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	c, err := di.NewWorkerContainer(ctx, cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize container: %v", err)
+	}
+	defer c.Close()
+
+	if err := c.OutboxProcessor.Start(ctx); err != nil {
+		log.Fatalf("Failed to start outbox processor: %v", err)
+	}
+
+	log.Println("Worker started")
+	<-ctx.Done()
+	log.Println("Shutting down...")
+}
@@ -12,10 +12,27 @@ func NewNodeTemplateMapper() *NodeTemplateMapper {
 }
 
 func (m *NodeTemplateMapper) To(nodeTemplate *aggregate.NodeTemplate) (*inbound.NodeTemplateDTO, error) {
+	var rateLimit *inbound.RateLimitDTO
+	if nodeTemplate.RateLimit != nil {
+		rateLimit = &inbound.RateLimitDTO{
+			Rate:  nodeTemplate.RateLimit.Rate,
+			Burst: nodeTemplate.RateLimit.Burst,
+		}
+	}
+
 	return &inbound.NodeTemplateDTO{
 		ID:        nodeTemplate.ID,
 		Name:      nodeTemplate.Name,
+		Type:      nodeTemplate.Type,
+		RateLimit: rateLimit,
 		CreatedAt: nodeTemplate.CreatedAt,
 		UpdatedAt: nodeTemplate.UpdatedAt,
 	}, nil
 }
+
+func (m *NodeTemplateMapper) RateLimitFrom(rateLimit *inbound.RateLimitDTO) (*aggregate.RateLimit, error) {
+	if rateLimit == nil {
+		return nil, nil
+	}
+	return aggregate.NewRateLimit(rateLimit.Rate, rateLimit.Burst)
+}
@@ -39,6 +39,11 @@ func NewNodeTemplateWriteService(
 }
 
 func (s *NodeTemplateWriteService) Create(ctx context.Context, input inbound.CreateNodeTemplateInput) (*inbound.NodeTemplateDTO, error) {
+	rateLimit, err := s.mapper.RateLimitFrom(input.RateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit: %w", err)
+	}
+
 	uow := s.uowFactory.Create()
 
 	// Create repository bound to THIS UoW
@@ -55,7 +60,7 @@ func (s *NodeTemplateWriteService) Create(ctx context.Context, input inbound.Cre
 		}
 	}()
 
-	nodeTemplate := s.factory.Make(input.Name)
+	nodeTemplate := s.factory.Make(input.Name, input.Type, rateLimit)
 
 	// Save using the UoW-bound repository
 	if err = writeRepo.Save(txCtx, nodeTemplate); err != nil {
@@ -70,6 +75,11 @@ func (s *NodeTemplateWriteService) Create(ctx context.Context, input inbound.Cre
 }
 
 func (s *NodeTemplateWriteService) Update(ctx context.Context, id string, input inbound.UpdateNodeTemplateInput) (*inbound.NodeTemplateDTO, error) {
+	rateLimit, err := s.mapper.RateLimitFrom(input.RateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit: %w", err)
+	}
+
 	uow := s.uowFactory.Create()
 
 	// Create repositories bound to THIS UoW
@@ -95,8 +105,9 @@ func (s *NodeTemplateWriteService) Update(ctx context.Context, id string, input
 		return nil, fmt.Errorf("node template not found: %s", id)
 	}
 
-	// Update aggregate (this adds UpdateNodeTemplate event)
+	// Update aggregate (this adds UpdateNodeTemplate, and UpdateNodeTemplateRateLimit if the limit changed)
 	nodeTemplate.UpdateName(s.idFactory, input.Name)
+	nodeTemplate.UpdateRateLimit(s.idFactory, rateLimit)
 
 	// Update using UoW-bound repository
 	if err = writeRepo.Update(txCtx, nodeTemplate); err != nil {
@@ -0,0 +1,42 @@
+package outbound
+
+import (
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+// Node types of the built-in executors.
+const (
+	NodeTypeHTTPRequest      = "http.request"
+	NodeTypeSetFields        = "transform.set"
+	NodeTypeRenameFields     = "transform.rename"
+	NodeTypeRemoveFields     = "transform.remove"
+	NodeTypeFilterArray      = "transform.filter"
+	NodeTypeMapArray         = "transform.map"
+	NodeTypeMergeObjects     = "transform.merge"
+	NodeTypeSortArray        = "transform.sort"
+	NodeTypeDeduplicateArray = "transform.deduplicate"
+	NodeTypeAggregateArray   = "transform.aggregate"
+	NodeTypeSQLQuery         = "sql.query"
+	NodeTypeSendEmail        = "email.send"
+	NodeTypeScript           = "script.run"
+)
+
+// NewBuiltinNodeExecutors returns the built-in executors keyed by node type.
+func NewBuiltinNodeExecutors(evaluator outbound.ExpressionEvaluator, idFactory id.Factory) map[string]outbound.NodeExecutor {
+	return map[string]outbound.NodeExecutor{
+		NodeTypeHTTPRequest:      NewHTTPRequestExecutor(),
+		NodeTypeSetFields:        NewSetFieldsExecutor(),
+		NodeTypeRenameFields:     NewRenameFieldsExecutor(),
+		NodeTypeRemoveFields:     NewRemoveFieldsExecutor(),
+		NodeTypeFilterArray:      NewFilterArrayExecutor(evaluator),
+		NodeTypeMapArray:         NewMapArrayExecutor(evaluator),
+		NodeTypeMergeObjects:     NewMergeObjectsExecutor(),
+		NodeTypeSortArray:        NewSortArrayExecutor(),
+		NodeTypeDeduplicateArray: NewDeduplicateArrayExecutor(),
+		NodeTypeAggregateArray:   NewAggregateArrayExecutor(),
+		NodeTypeSQLQuery:         NewSQLQueryExecutor(),
+		NodeTypeSendEmail:        NewSendEmailExecutor(idFactory),
+		NodeTypeScript:           NewScriptExecutor(),
+	}
+}
@@ -0,0 +1,279 @@
+package outbound
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+const (
+	defaultHTTPRequestTimeout          = 30 * time.Second
+	defaultHTTPRequestMaxResponseBytes = 10 << 20 // 10 MiB
+)
+
+type HTTPRequestConfig struct {
+	Method           string            `json:"method"`
+	URL              string            `json:"url"`
+	Headers          map[string]string `json:"headers"`
+	Query            map[string]string `json:"query"`
+	Body             *HTTPRequestBody  `json:"body"`
+	TimeoutMs        int               `json:"timeoutMs"`
+	MaxResponseBytes int64             `json:"maxResponseBytes"`
+	// RetryableStatusCodes lists the non-2xx statuses that are retried. When
+	// empty, 408, 429 and every 5xx are retried and other statuses are not.
+	RetryableStatusCodes []int `json:"retryableStatusCodes"`
+}
+
+// HTTPRequestBody is sent as JSON, form-urlencoded or raw depending on Type.
+type HTTPRequestBody struct {
+	Type        string            `json:"type"`
+	JSON        any               `json:"json"`
+	Form        map[string]string `json:"form"`
+	Raw         string            `json:"raw"`
+	ContentType string            `json:"contentType"`
+}
+
+// HTTPResponseOutput holds header names in lower case. Repeated headers are
+// joined with ", ", except Set-Cookie, whose values may contain commas and
+// are listed in SetCookies instead. Body is parsed JSON, a string for text
+// responses, or NodeBinaryData for anything else, such as images or PDFs.
+type HTTPResponseOutput struct {
+	Status     int               `json:"status"`
+	Headers    map[string]string `json:"headers"`
+	SetCookies []string          `json:"setCookies,omitempty"`
+	Body       any               `json:"body"`
+}
+
+type HTTPRequestExecutor struct {
+	client *http.Client
+}
+
+func NewHTTPRequestExecutor() *HTTPRequestExecutor {
+	return &HTTPRequestExecutor{client: &http.Client{}}
+}
+
+func (e *HTTPRequestExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config HTTPRequestConfig
+	if err := json.Unmarshal(request.Config, &config); err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("invalid http request config: %w", err))
+	}
+
+	timeout := defaultHTTPRequestTimeout
+	if config.TimeoutMs > 0 {
+		timeout = time.Duration(config.TimeoutMs) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := buildHTTPRequest(ctx, &config, request.Credential)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		// Timeouts and connection errors are usually transient.
+		return nil, outbound.NewRetryableNodeExecutionError(fmt.Errorf("http request failed: %w", err))
+	}
+	defer resp.Body.Close()
+
+	maxBytes := int64(defaultHTTPRequestMaxResponseBytes)
+	if config.MaxResponseBytes > 0 {
+		maxBytes = config.MaxResponseBytes
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, outbound.NewRetryableNodeExecutionError(fmt.Errorf("failed to read http response: %w", err))
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("http response exceeds %d bytes", maxBytes))
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusErr := fmt.Errorf("http request returned status %d: %s", resp.StatusCode, truncate(string(body), 512))
+		if isRetryableStatus(resp.StatusCode, config.RetryableStatusCodes) {
+			return nil, outbound.NewRetryableNodeExecutionError(statusErr)
+		}
+		return nil, outbound.NewPermanentNodeExecutionError(statusErr)
+	}
+
+	headers := make(map[string]string, len(resp.Header))
+	for name, values := range resp.Header {
+		if name == "Set-Cookie" {
+			continue
+		}
+		headers[strings.ToLower(name)] = strings.Join(values, ", ")
+	}
+
+	return &outbound.NodeExecutionResult{
+		Output: &HTTPResponseOutput{
+			Status:     resp.StatusCode,
+			Headers:    headers,
+			SetCookies: resp.Header.Values("Set-Cookie"),
+			Body:       parseHTTPResponseBody(resp, body),
+		},
+	}, nil
+}
+
+func buildHTTPRequest(ctx context.Context, config *HTTPRequestConfig, credential *outbound.NodeCredential) (*http.Request, error) {
+	method := strings.ToUpper(config.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	target, err := url.Parse(config.URL)
+	if err != nil || target.Scheme == "" || target.Host == "" {
+		return nil, fmt.Errorf("invalid url %q", config.URL)
+	}
+	query := target.Query()
+	for name, value := range config.Query {
+		query.Set(name, value)
+	}
+
+	headers := make(http.Header)
+	for name, value := range config.Headers {
+		headers.Set(name, value)
+	}
+
+	if credential != nil {
+		if err := applyHTTPCredential(headers, query, credential); err != nil {
+			return nil, err
+		}
+	}
+	target.RawQuery = query.Encode()
+
+	body, contentType, err := encodeHTTPRequestBody(config.Body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" && headers.Get("Content-Type") == "" {
+		headers.Set("Content-Type", contentType)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http request: %w", err)
+	}
+	req.Header = headers
+
+	return req, nil
+}
+
+func encodeHTTPRequestBody(body *HTTPRequestBody) (io.Reader, string, error) {
+	if body == nil {
+		return nil, "", nil
+	}
+
+	switch body.Type {
+	case "json":
+		data, err := json.Marshal(body.JSON)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to encode json body: %w", err)
+		}
+		return bytes.NewReader(data), "application/json", nil
+	case "form":
+		form := make(url.Values, len(body.Form))
+		for name, value := range body.Form {
+			form.Set(name, value)
+		}
+		return strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", nil
+	case "raw":
+		return strings.NewReader(body.Raw), body.ContentType, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported body type %q", body.Type)
+	}
+}
+
+func applyHTTPCredential(headers http.Header, query url.Values, credential *outbound.NodeCredential) error {
+	switch credential.Type {
+	case "bearer":
+		headers.Set("Authorization", "Bearer "+credential.Data["token"])
+	case "basic":
+		userinfo := credential.Data["username"] + ":" + credential.Data["password"]
+		headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(userinfo)))
+	case "header":
+		headers.Set(credential.Data["name"], credential.Data["value"])
+	case "query":
+		query.Set(credential.Data["name"], credential.Data["value"])
+	default:
+		return fmt.Errorf("unsupported credential type %q", credential.Type)
+	}
+	return nil
+}
+
+func isRetryableStatus(status int, retryable []int) bool {
+	if len(retryable) > 0 {
+		return slices.Contains(retryable, status)
+	}
+	return status == http.StatusRequestTimeout || status == http.StatusTooManyRequests || status >= 500
+}
+
+// parseHTTPResponseBody decodes JSON responses, returns text responses as a
+// string and everything else as NodeBinaryData. Without a Content-Type, the
+// body is text if it is valid UTF-8. Binary data is named after the
+// Content-Disposition filename, or else the last segment of the URL path, so
+// it can be attached to an email as is.
+func parseHTTPResponseBody(resp *http.Response, body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") {
+		var parsed any
+		if err := json.Unmarshal(body, &parsed); err == nil {
+			return parsed
+		}
+	}
+
+	if textMediaType(mediaType) || (contentType == "" && utf8.Valid(body)) {
+		return string(body)
+	}
+
+	binary := &outbound.NodeBinaryData{MimeType: mediaType, Data: body}
+	if binary.MimeType == "" {
+		binary.MimeType = "application/octet-stream"
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		binary.FileName = params["filename"]
+	}
+	if binary.FileName == "" && resp.Request != nil {
+		binary.FileName = path.Base(resp.Request.URL.Path)
+	}
+	if binary.FileName == "/" || binary.FileName == "." {
+		binary.FileName = "download"
+	}
+	return binary
+}
+
+func textMediaType(mediaType string) bool {
+	switch mediaType {
+	case "application/json", "application/xml", "application/javascript",
+		"application/x-www-form-urlencoded", "application/yaml":
+		return true
+	}
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "+json") ||
+		strings.HasSuffix(mediaType, "+xml")
+}
+
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit]
+}
@@ -0,0 +1,317 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+func executeHTTPRequest(t *testing.T, config map[string]any, credential *outbound.NodeCredential) (*HTTPResponseOutput, error) {
+	t.Helper()
+
+	raw, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+
+	result, err := NewHTTPRequestExecutor().Execute(context.Background(), &outbound.NodeExecutionRequest{
+		Config:     raw,
+		Credential: credential,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Output.(*HTTPResponseOutput), nil
+}
+
+func TestHTTPRequestExecutor_SendsJSONBodyAndParsesJSONResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if r.URL.Query().Get("page") != "2" {
+			t.Errorf("Expected query page=2, got %q", r.URL.RawQuery)
+		}
+		if r.Header.Get("X-Trace") != "abc" {
+			t.Errorf("Expected X-Trace header, got %q", r.Header.Get("X-Trace"))
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected JSON content type, got %q", r.Header.Get("Content-Type"))
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"Ada"}` {
+			t.Errorf("Unexpected body %s", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Add("Link", `</contacts?page=1>; rel="prev"`)
+		w.Header().Add("Link", `</contacts?page=3>; rel="next"`)
+		w.Header().Add("Set-Cookie", "session=1; Expires=Wed, 21 Oct 2026 07:28:00 GMT")
+		w.Header().Add("Set-Cookie", "theme=dark")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":42}`))
+	}))
+	defer server.Close()
+
+	output, err := executeHTTPRequest(t, map[string]any{
+		"method":  "post",
+		"url":     server.URL + "/contacts",
+		"headers": map[string]string{"X-Trace": "abc"},
+		"query":   map[string]string{"page": "2"},
+		"body":    map[string]any{"type": "json", "json": map[string]string{"name": "Ada"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if output.Status != http.StatusCreated {
+		t.Errorf("Expected status 201, got %d", output.Status)
+	}
+	if output.Headers["x-request-id"] != "req-1" {
+		t.Errorf("Expected x-request-id header, got %v", output.Headers)
+	}
+	if want := `</contacts?page=1>; rel="prev", </contacts?page=3>; rel="next"`; output.Headers["link"] != want {
+		t.Errorf("Expected joined link header %q, got %q", want, output.Headers["link"])
+	}
+	if len(output.SetCookies) != 2 || output.SetCookies[1] != "theme=dark" {
+		t.Errorf("Expected both cookies in SetCookies, got %v", output.SetCookies)
+	}
+	if _, ok := output.Headers["set-cookie"]; ok {
+		t.Errorf("Expected set-cookie to be left out of headers, got %v", output.Headers)
+	}
+	body, ok := output.Body.(map[string]any)
+	if !ok || body["id"] != float64(42) {
+		t.Errorf("Expected parsed JSON body, got %#v", output.Body)
+	}
+}
+
+func TestHTTPRequestExecutor_ParsesBodyByContentType(t *testing.T) {
+	png := []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0xff}
+
+	tests := []struct {
+		name               string
+		path               string
+		contentType        string
+		contentDisposition string
+		body               []byte
+		want               any
+	}{
+		{
+			name:        "text stays a string",
+			contentType: "text/csv; charset=utf-8",
+			body:        []byte("id,name\n1,Ada\n"),
+			want:        "id,name\n1,Ada\n",
+		},
+		{
+			name:        "xml stays a string",
+			contentType: "application/atom+xml",
+			body:        []byte("<feed/>"),
+			want:        "<feed/>",
+		},
+		{
+			name:               "image becomes binary data",
+			contentType:        "image/png",
+			contentDisposition: `attachment; filename="logo.png"`,
+			body:               png,
+			want:               &outbound.NodeBinaryData{FileName: "logo.png", MimeType: "image/png", Data: png},
+		},
+		{
+			name:        "binary data is named after the url path",
+			path:        "/files/report.pdf",
+			contentType: "application/pdf",
+			body:        png,
+			want:        &outbound.NodeBinaryData{FileName: "report.pdf", MimeType: "application/pdf", Data: png},
+		},
+		{
+			name: "untyped binary becomes octet-stream",
+			body: png,
+			want: &outbound.NodeBinaryData{FileName: "download", MimeType: "application/octet-stream", Data: png},
+		},
+		{
+			name: "untyped UTF-8 stays a string",
+			body: []byte("ok"),
+			want: "ok",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// An empty Content-Type keeps net/http from sniffing one.
+				w.Header()["Content-Type"] = []string{tt.contentType}
+				if tt.contentDisposition != "" {
+					w.Header().Set("Content-Disposition", tt.contentDisposition)
+				}
+				w.Write(tt.body)
+			}))
+			defer server.Close()
+
+			output, err := executeHTTPRequest(t, map[string]any{"url": server.URL + tt.path}, nil)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(output.Body, tt.want) {
+				t.Errorf("Expected body %#v, got %#v", tt.want, output.Body)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestExecutor_SendsFormBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Failed to parse form: %v", err)
+		}
+		if r.PostForm.Get("grant_type") != "client_credentials" {
+			t.Errorf("Expected grant_type form field, got %v", r.PostForm)
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	output, err := executeHTTPRequest(t, map[string]any{
+		"method": "POST",
+		"url":    server.URL,
+		"body":   map[string]any{"type": "form", "form": map[string]string{"grant_type": "client_credentials"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if output.Body != "ok" {
+		t.Errorf("Expected text body, got %#v", output.Body)
+	}
+}
+
+func TestHTTPRequestExecutor_AppliesCredential(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential *outbound.NodeCredential
+		check      func(r *http.Request) bool
+	}{
+		{
+			name:       "bearer",
+			credential: &outbound.NodeCredential{Type: "bearer", Data: map[string]string{"token": "secret"}},
+			check:      func(r *http.Request) bool { return r.Header.Get("Authorization") == "Bearer secret" },
+		},
+		{
+			name:       "basic",
+			credential: &outbound.NodeCredential{Type: "basic", Data: map[string]string{"username": "user", "password": "pass"}},
+			check: func(r *http.Request) bool {
+				username, password, ok := r.BasicAuth()
+				return ok && username == "user" && password == "pass"
+			},
+		},
+		{
+			name:       "header",
+			credential: &outbound.NodeCredential{Type: "header", Data: map[string]string{"name": "X-Api-Key", "value": "k"}},
+			check:      func(r *http.Request) bool { return r.Header.Get("X-Api-Key") == "k" },
+		},
+		{
+			name:       "query",
+			credential: &outbound.NodeCredential{Type: "query", Data: map[string]string{"name": "api_key", "value": "k"}},
+			check:      func(r *http.Request) bool { return r.URL.Query().Get("api_key") == "k" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.check(r) {
+					t.Errorf("Credential not applied to request")
+				}
+			}))
+			defer server.Close()
+
+			if _, err := executeHTTPRequest(t, map[string]any{"url": server.URL}, tt.credential); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestExecutor_MapsStatusToRetryability(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		retryable     []int
+		wantRetryable bool
+	}{
+		{"server error is retryable by default", http.StatusBadGateway, nil, true},
+		{"too many requests is retryable by default", http.StatusTooManyRequests, nil, true},
+		{"client error is permanent by default", http.StatusBadRequest, nil, false},
+		{"configured status is retryable", http.StatusConflict, []int{http.StatusConflict}, true},
+		{"unlisted server error is permanent when configured", http.StatusInternalServerError, []int{http.StatusConflict}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			_, err := executeHTTPRequest(t, map[string]any{
+				"url":                  server.URL,
+				"retryableStatusCodes": tt.retryable,
+			}, nil)
+			if err == nil {
+				t.Fatal("Expected error for non-2xx status")
+			}
+			if got := outbound.IsRetryableNodeExecutionError(err); got != tt.wantRetryable {
+				t.Errorf("Expected retryable=%v, got %v (%v)", tt.wantRetryable, got, err)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestExecutor_EnforcesTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	_, err := executeHTTPRequest(t, map[string]any{"url": server.URL, "timeoutMs": 50}, nil)
+	if err == nil {
+		t.Fatal("Expected timeout error")
+	}
+	if !outbound.IsRetryableNodeExecutionError(err) {
+		t.Errorf("Expected timeout to be retryable, got %v", err)
+	}
+}
+
+func TestHTTPRequestExecutor_EnforcesResponseSizeLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("a", 100)))
+	}))
+	defer server.Close()
+
+	_, err := executeHTTPRequest(t, map[string]any{"url": server.URL, "maxResponseBytes": 10}, nil)
+	if err == nil {
+		t.Fatal("Expected response size error")
+	}
+	if outbound.IsRetryableNodeExecutionError(err) {
+		t.Errorf("Expected oversized response to be permanent, got %v", err)
+	}
+}
+
+func TestHTTPRequestExecutor_RejectsInvalidConfig(t *testing.T) {
+	_, err := executeHTTPRequest(t, map[string]any{"url": "not a url"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for invalid url")
+	}
+	if outbound.IsRetryableNodeExecutionError(err) {
+		t.Errorf("Expected invalid config to be permanent, got %v", err)
+	}
+}
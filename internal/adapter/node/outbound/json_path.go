@@ -0,0 +1,83 @@
+package outbound
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// JSON values handled by the built-in executors are the types produced by
+// encoding/json: map[string]any, []any, string, float64, bool and nil. Paths
+// are dot separated object keys, e.g. "customer.address.city".
+
+func getPath(value any, path string) (any, bool) {
+	if path == "" {
+		return value, true
+	}
+
+	current := value
+	for _, key := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// setPath sets path on object, creating intermediate objects as needed.
+func setPath(object map[string]any, path string, value any) error {
+	keys := strings.Split(path, ".")
+	current := object
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key]
+		if !ok {
+			child := make(map[string]any)
+			current[key] = child
+			current = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot set %q: %q is not an object", path, key)
+		}
+		current = child
+	}
+	current[keys[len(keys)-1]] = value
+	return nil
+}
+
+// deletePath removes path from object and reports whether it was present.
+func deletePath(object map[string]any, path string) bool {
+	keys := strings.Split(path, ".")
+	parent, ok := getPath(object, strings.Join(keys[:len(keys)-1], "."))
+	if !ok {
+		return false
+	}
+	parentObject, ok := parent.(map[string]any)
+	if !ok {
+		return false
+	}
+	if _, ok := parentObject[keys[len(keys)-1]]; !ok {
+		return false
+	}
+	delete(parentObject, keys[len(keys)-1])
+	return true
+}
+
+// cloneJSON deep-copies value so executors never mutate upstream outputs.
+func cloneJSON(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("input is not JSON serializable: %w", err)
+	}
+	var cloned any
+	if err := json.Unmarshal(data, &cloned); err != nil {
+		return nil, fmt.Errorf("failed to copy input: %w", err)
+	}
+	return cloned, nil
+}
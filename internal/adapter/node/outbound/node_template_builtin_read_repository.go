@@ -0,0 +1,58 @@
+package outbound
+
+import (
+	"context"
+
+	"use-open-workflow.io/engine/internal/domain/node/aggregate"
+	nodeOutbound "use-open-workflow.io/engine/internal/port/node/outbound"
+	"use-open-workflow.io/engine/internal/port/outbound"
+)
+
+// NodeTemplateBuiltinReadRepository lists the built-in templates ahead of the
+// stored ones.
+type NodeTemplateBuiltinReadRepository struct {
+	builtin *NodeTemplateStaticReadRepository
+	stored  nodeOutbound.NodeTemplateReadRepository
+}
+
+func NewNodeTemplateBuiltinReadRepository(stored nodeOutbound.NodeTemplateReadRepository) *NodeTemplateBuiltinReadRepository {
+	return &NodeTemplateBuiltinReadRepository{
+		builtin: NewNodeTemplateStaticReadRepository(),
+		stored:  stored,
+	}
+}
+
+func (r *NodeTemplateBuiltinReadRepository) FindMany(ctx context.Context) ([]*aggregate.NodeTemplate, error) {
+	builtin, err := r.builtin.FindMany(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := r.stored.FindMany(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(builtin, stored...), nil
+}
+
+func (r *NodeTemplateBuiltinReadRepository) FindByID(ctx context.Context, id string) (*aggregate.NodeTemplate, error) {
+	template, err := r.builtin.FindByID(ctx, id)
+	if err != nil || template != nil {
+		return template, err
+	}
+	return r.stored.FindByID(ctx, id)
+}
+
+// NodeTemplateBuiltinReadRepositoryFactory adds the built-in templates to the
+// repositories of another factory. It is meant for reads only: the built-in
+// templates cannot be updated or deleted.
+type NodeTemplateBuiltinReadRepositoryFactory struct {
+	stored nodeOutbound.NodeTemplateReadRepositoryFactory
+}
+
+func NewNodeTemplateBuiltinReadRepositoryFactory(stored nodeOutbound.NodeTemplateReadRepositoryFactory) *NodeTemplateBuiltinReadRepositoryFactory {
+	return &NodeTemplateBuiltinReadRepositoryFactory{stored: stored}
+}
+
+func (f *NodeTemplateBuiltinReadRepositoryFactory) Create(uow outbound.UnitOfWork) nodeOutbound.NodeTemplateReadRepository {
+	return NewNodeTemplateBuiltinReadRepository(f.stored.Create(uow))
+}
@@ -12,18 +12,38 @@ func NewNodeTemplateMapper() *NodeTemplateMapper {
 }
 
 func (*NodeTemplateMapper) From(in *outbound.NodeTemplateModel) (*aggregate.NodeTemplate, error) {
+	var rateLimit *aggregate.RateLimit
+	if in.RateLimit != nil {
+		rateLimit = &aggregate.RateLimit{
+			Rate:  in.RateLimit.Rate,
+			Burst: in.RateLimit.Burst,
+		}
+	}
+
 	return aggregate.ReconstituteNodeTemplate(
 		in.ID,
 		in.Name,
+		in.Type,
+		rateLimit,
 		in.CreatedAt,
 		in.UpdatedAt,
 	), nil
 }
 
 func (*NodeTemplateMapper) To(in *aggregate.NodeTemplate) (*outbound.NodeTemplateModel, error) {
+	var rateLimit *outbound.RateLimitModel
+	if in.RateLimit != nil {
+		rateLimit = &outbound.RateLimitModel{
+			Rate:  in.RateLimit.Rate,
+			Burst: in.RateLimit.Burst,
+		}
+	}
+
 	return &outbound.NodeTemplateModel{
 		ID:        in.ID,
 		Name:      in.Name,
+		Type:      in.Type,
+		RateLimit: rateLimit,
 		CreatedAt: in.CreatedAt,
 		UpdatedAt: in.UpdatedAt,
 	}, nil
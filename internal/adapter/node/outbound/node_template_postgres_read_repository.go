@@ -25,7 +25,7 @@ func (r *NodeTemplatePostgresReadRepository) FindMany(ctx context.Context) ([]*a
 	q := r.uow.Querier(ctx)
 
 	rows, err := q.Query(ctx, `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, node_type, rate_limit_rate, rate_limit_burst, created_at, updated_at
 		FROM node_templates
 		ORDER BY created_at DESC
 	`)
@@ -36,13 +36,15 @@ func (r *NodeTemplatePostgresReadRepository) FindMany(ctx context.Context) ([]*a
 
 	var templates []*aggregate.NodeTemplate
 	for rows.Next() {
-		var id, name string
+		var id, name, nodeType string
+		var rate *float64
+		var burst *int
 		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&id, &name, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&id, &name, &nodeType, &rate, &burst, &createdAt, &updatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan node template: %w", err)
 		}
 
-		template := aggregate.ReconstituteNodeTemplate(id, name, createdAt, updatedAt)
+		template := aggregate.ReconstituteNodeTemplate(id, name, nodeType, reconstituteRateLimit(rate, burst), createdAt, updatedAt)
 		templates = append(templates, template)
 	}
 
@@ -56,13 +58,15 @@ func (r *NodeTemplatePostgresReadRepository) FindMany(ctx context.Context) ([]*a
 func (r *NodeTemplatePostgresReadRepository) FindByID(ctx context.Context, id string) (*aggregate.NodeTemplate, error) {
 	q := r.uow.Querier(ctx)
 
-	var name string
+	var name, nodeType string
+	var rate *float64
+	var burst *int
 	var createdAt, updatedAt time.Time
 	err := q.QueryRow(ctx, `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, node_type, rate_limit_rate, rate_limit_burst, created_at, updated_at
 		FROM node_templates
 		WHERE id = $1
-	`, id).Scan(&id, &name, &createdAt, &updatedAt)
+	`, id).Scan(&id, &name, &nodeType, &rate, &burst, &createdAt, &updatedAt)
 
 	if err != nil && err.Error() == "no rows in result set" {
 		return nil, nil
@@ -71,5 +75,15 @@ func (r *NodeTemplatePostgresReadRepository) FindByID(ctx context.Context, id st
 		return nil, fmt.Errorf("failed to query node template: %w", err)
 	}
 
-	return aggregate.ReconstituteNodeTemplate(id, name, createdAt, updatedAt), nil
+	return aggregate.ReconstituteNodeTemplate(id, name, nodeType, reconstituteRateLimit(rate, burst), createdAt, updatedAt), nil
+}
+
+func reconstituteRateLimit(rate *float64, burst *int) *aggregate.RateLimit {
+	if rate == nil || burst == nil {
+		return nil
+	}
+	return &aggregate.RateLimit{
+		Rate:  *rate,
+		Burst: *burst,
+	}
 }
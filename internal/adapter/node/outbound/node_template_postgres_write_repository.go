@@ -22,11 +22,12 @@ func NewNodeTemplatePostgresWriteRepository(
 
 func (r *NodeTemplatePostgresWriteRepository) Save(ctx context.Context, nodeTemplate *aggregate.NodeTemplate) error {
 	q := r.uow.Querier(ctx)
+	rate, burst := rateLimitColumns(nodeTemplate.RateLimit)
 
 	_, err := q.Exec(ctx, `
-		INSERT INTO node_templates (id, name, created_at, updated_at)
-		VALUES ($1, $2, $3, $4)
-	`, nodeTemplate.ID, nodeTemplate.Name, nodeTemplate.CreatedAt, nodeTemplate.UpdatedAt)
+		INSERT INTO node_templates (id, name, node_type, rate_limit_rate, rate_limit_burst, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, nodeTemplate.ID, nodeTemplate.Name, nodeTemplate.Type, rate, burst, nodeTemplate.CreatedAt, nodeTemplate.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("failed to save node template: %w", err)
@@ -39,12 +40,13 @@ func (r *NodeTemplatePostgresWriteRepository) Save(ctx context.Context, nodeTemp
 
 func (r *NodeTemplatePostgresWriteRepository) Update(ctx context.Context, nodeTemplate *aggregate.NodeTemplate) error {
 	q := r.uow.Querier(ctx)
+	rate, burst := rateLimitColumns(nodeTemplate.RateLimit)
 
 	_, err := q.Exec(ctx, `
 		UPDATE node_templates
-		SET name = $1, updated_at = $2
-		WHERE id = $3
-	`, nodeTemplate.Name, nodeTemplate.UpdatedAt, nodeTemplate.ID)
+		SET name = $1, rate_limit_rate = $2, rate_limit_burst = $3, updated_at = $4
+		WHERE id = $5
+	`, nodeTemplate.Name, rate, burst, nodeTemplate.UpdatedAt, nodeTemplate.ID)
 
 	if err != nil {
 		return fmt.Errorf("failed to update node template: %w", err)
@@ -69,3 +71,10 @@ func (r *NodeTemplatePostgresWriteRepository) Delete(ctx context.Context, id str
 
 	return nil
 }
+
+func rateLimitColumns(rateLimit *aggregate.RateLimit) (*float64, *int) {
+	if rateLimit == nil {
+		return nil, nil
+	}
+	return &rateLimit.Rate, &rateLimit.Burst
+}
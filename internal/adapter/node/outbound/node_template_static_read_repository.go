@@ -2,10 +2,34 @@ package outbound
 
 import (
 	"context"
+	"time"
 
 	"use-open-workflow.io/engine/internal/domain/node/aggregate"
 )
 
+// builtinNodeTemplateNames lists a template for every built-in executor.
+var builtinNodeTemplateNames = []struct {
+	nodeType string
+	name     string
+}{
+	{NodeTypeHTTPRequest, "HTTP Request"},
+	{NodeTypeSetFields, "Set Fields"},
+	{NodeTypeRenameFields, "Rename Fields"},
+	{NodeTypeRemoveFields, "Remove Fields"},
+	{NodeTypeFilterArray, "Filter Array"},
+	{NodeTypeMapArray, "Map Array"},
+	{NodeTypeMergeObjects, "Merge Objects"},
+	{NodeTypeSortArray, "Sort Array"},
+	{NodeTypeDeduplicateArray, "Deduplicate Array"},
+	{NodeTypeAggregateArray, "Aggregate Array"},
+	{NodeTypeSQLQuery, "SQL Query"},
+	{NodeTypeSendEmail, "Send Email"},
+	{NodeTypeScript, "Script"},
+}
+
+// NodeTemplateStaticReadRepository serves the built-in node templates. Their
+// ID is their node type, which never collides with the ULIDs of stored
+// templates.
 type NodeTemplateStaticReadRepository struct{}
 
 func NewNodeTemplateStaticReadRepository() *NodeTemplateStaticReadRepository {
@@ -13,9 +37,18 @@ func NewNodeTemplateStaticReadRepository() *NodeTemplateStaticReadRepository {
 }
 
 func (s *NodeTemplateStaticReadRepository) FindMany(ctx context.Context) ([]*aggregate.NodeTemplate, error) {
-	return nil, nil
+	templates := make([]*aggregate.NodeTemplate, len(builtinNodeTemplateNames))
+	for i, builtin := range builtinNodeTemplateNames {
+		templates[i] = aggregate.ReconstituteNodeTemplate(builtin.nodeType, builtin.name, builtin.nodeType, nil, time.Time{}, time.Time{})
+	}
+	return templates, nil
 }
 
 func (s *NodeTemplateStaticReadRepository) FindByID(ctx context.Context, id string) (*aggregate.NodeTemplate, error) {
+	for _, builtin := range builtinNodeTemplateNames {
+		if builtin.nodeType == id {
+			return aggregate.ReconstituteNodeTemplate(builtin.nodeType, builtin.name, builtin.nodeType, nil, time.Time{}, time.Time{}), nil
+		}
+	}
 	return nil, nil
 }
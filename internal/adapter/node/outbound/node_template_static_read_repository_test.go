@@ -0,0 +1,31 @@
+package outbound
+
+import (
+	"context"
+	"testing"
+
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+func TestNodeTemplateStaticReadRepository_EveryTemplateHasAnExecutor(t *testing.T) {
+	executors := NewBuiltinNodeExecutors(NewStarlarkExpressionEvaluator(), id.NewULIDFactory())
+	repository := NewNodeTemplateStaticReadRepository()
+
+	templates, err := repository.FindMany(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(templates) != len(executors) {
+		t.Errorf("Expected a template for each of the %d executors, got %d", len(executors), len(templates))
+	}
+
+	for _, template := range templates {
+		if _, ok := executors[template.Type]; !ok {
+			t.Errorf("Template %s has no executor for type %s", template.ID, template.Type)
+		}
+		found, err := repository.FindByID(context.Background(), template.ID)
+		if err != nil || found == nil || found.Type != template.Type {
+			t.Errorf("FindByID(%s) = %v, %v", template.ID, found, err)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package outbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"runtime/metrics"
+	"time"
+
+	starlarkjson "go.starlark.net/lib/json"
+	starlarkmath "go.starlark.net/lib/math"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+const (
+	defaultScriptMaxSteps       = 10_000_000
+	defaultScriptTimeout        = 5 * time.Second
+	defaultScriptMaxMemoryBytes = 64 << 20 // 64 MiB
+	scriptMemoryCheckInterval   = 10 * time.Millisecond
+)
+
+// errScriptTimeout is the cause of the script's own deadline, to tell it
+// apart from the caller cancelling the run.
+var errScriptTimeout = errors.New("script timed out")
+
+// ScriptConfig holds a Starlark program that defines main(input); the value
+// main returns becomes the node output.
+type ScriptConfig struct {
+	Source         string `json:"source"`
+	MaxSteps       uint64 `json:"maxSteps"`
+	TimeoutMs      int    `json:"timeoutMs"`
+	MaxMemoryBytes uint64 `json:"maxMemoryBytes"`
+}
+
+// ScriptExecutor runs user code in a Starlark interpreter. Starlark has no
+// file system, network or clock access, and load() is disabled, so scripts
+// only see their input plus the json and math modules.
+//
+// CPU is bounded by MaxSteps and the timeout. Memory is bounded by watching
+// heap growth while the script runs and cancelling it once growth exceeds
+// MaxMemoryBytes. The heap is shared by the whole process, so this limit is
+// best effort when several scripts run at the same time.
+//
+// Running out of steps, time or memory fails the node permanently. A run
+// cancelled by the caller, for example on shutdown, fails with a retryable
+// error instead.
+type ScriptExecutor struct{}
+
+func NewScriptExecutor() *ScriptExecutor {
+	return &ScriptExecutor{}
+}
+
+func (e *ScriptExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config ScriptConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+	if config.Source == "" {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("script source is required"))
+	}
+
+	maxSteps := uint64(defaultScriptMaxSteps)
+	if config.MaxSteps > 0 {
+		maxSteps = config.MaxSteps
+	}
+	timeout := defaultScriptTimeout
+	if config.TimeoutMs > 0 {
+		timeout = time.Duration(config.TimeoutMs) * time.Millisecond
+	}
+	maxMemory := uint64(defaultScriptMaxMemoryBytes)
+	if config.MaxMemoryBytes > 0 {
+		maxMemory = config.MaxMemoryBytes
+	}
+
+	input, err := cloneJSON(request.Input)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+	starlarkInput, err := toStarlark(input)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+
+	thread := &starlark.Thread{
+		Name:  "script",
+		Print: func(*starlark.Thread, string) {},
+		Load: func(*starlark.Thread, string) (starlark.StringDict, error) {
+			return nil, errors.New("load is not allowed in scripts")
+		},
+	}
+	thread.SetMaxExecutionSteps(maxSteps)
+
+	scriptCtx, cancel := context.WithTimeoutCause(ctx, timeout, errScriptTimeout)
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	go watchScript(scriptCtx, done, thread, maxMemory)
+
+	predeclared := starlark.StringDict{
+		"json": starlarkjson.Module,
+		"math": starlarkmath.Module,
+	}
+	globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, "script.star", config.Source, predeclared)
+	if err != nil {
+		return nil, scriptError(ctx, err)
+	}
+
+	main, ok := globals["main"].(starlark.Callable)
+	if !ok {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("script must define main(input)"))
+	}
+	result, err := starlark.Call(thread, main, starlark.Tuple{starlarkInput}, nil)
+	if err != nil {
+		return nil, scriptError(ctx, err)
+	}
+
+	output, err := fromStarlark(result)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("script returned a value that is not JSON serializable: %w", err))
+	}
+
+	return &outbound.NodeExecutionResult{Output: output}, nil
+}
+
+// watchScript cancels thread when ctx ends or when heap growth since the
+// script started exceeds maxMemory.
+//
+// The heap sample includes garbage, so the first time it crosses the limit a
+// GC is forced and the limit is only enforced if the growth survives it. A
+// full GC stops the world for the whole process, so it runs at most once per
+// script; later samples are trusted as they are.
+func watchScript(ctx context.Context, done <-chan struct{}, thread *starlark.Thread, maxMemory uint64) {
+	baseline := heapObjectBytes()
+	ticker := time.NewTicker(scriptMemoryCheckInterval)
+	defer ticker.Stop()
+
+	collected := false
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			if errors.Is(context.Cause(ctx), errScriptTimeout) {
+				thread.Cancel(errScriptTimeout.Error())
+			} else {
+				thread.Cancel("script cancelled")
+			}
+			return
+		case <-ticker.C:
+			if heapObjectBytes() < baseline+maxMemory {
+				continue
+			}
+			if !collected {
+				collected = true
+				runtime.GC()
+				if heapObjectBytes() < baseline+maxMemory {
+					continue
+				}
+			}
+			thread.Cancel(fmt.Sprintf("script exceeded memory limit of %d bytes", maxMemory))
+			return
+		}
+	}
+}
+
+func heapObjectBytes() uint64 {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+	return sample[0].Value.Uint64()
+}
+
+// scriptError fails the node permanently unless ctx, the caller's context,
+// was cancelled while the script ran.
+func scriptError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return outbound.NewRetryableNodeExecutionError(fmt.Errorf("script cancelled: %w", context.Cause(ctx)))
+	}
+	var evalErr *starlark.EvalError
+	if errors.As(err, &evalErr) {
+		return outbound.NewPermanentNodeExecutionError(fmt.Errorf("script failed: %s", evalErr.Backtrace()))
+	}
+	return outbound.NewPermanentNodeExecutionError(fmt.Errorf("script failed: %w", err))
+}
+
+func toStarlark(value any) (starlark.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return starlark.None, nil
+	case bool:
+		return starlark.Bool(v), nil
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return starlark.MakeInt64(int64(v)), nil
+		}
+		return starlark.Float(v), nil
+	case string:
+		return starlark.String(v), nil
+	case []any:
+		items := make([]starlark.Value, len(v))
+		for i, item := range v {
+			converted, err := toStarlark(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = converted
+		}
+		return starlark.NewList(items), nil
+	case map[string]any:
+		dict := starlark.NewDict(len(v))
+		for key, item := range v {
+			converted, err := toStarlark(item)
+			if err != nil {
+				return nil, err
+			}
+			dict.SetKey(starlark.String(key), converted)
+		}
+		return dict, nil
+	default:
+		return nil, fmt.Errorf("unsupported input type %T", value)
+	}
+}
+
+func fromStarlark(value starlark.Value) (any, error) {
+	switch v := value.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(v), nil
+	case starlark.Int:
+		if i, ok := v.Int64(); ok {
+			return i, nil
+		}
+		return nil, fmt.Errorf("int %s is too large", v)
+	case starlark.Float:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return nil, fmt.Errorf("float %s is not a JSON number", v)
+		}
+		return float64(v), nil
+	case starlark.String:
+		return string(v), nil
+	case *starlark.List:
+		return fromStarlarkSequence(v)
+	case starlark.Tuple:
+		return fromStarlarkSequence(v)
+	case *starlark.Dict:
+		object := make(map[string]any, v.Len())
+		for _, item := range v.Items() {
+			key, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("dict key %s is not a string", item[0])
+			}
+			converted, err := fromStarlark(item[1])
+			if err != nil {
+				return nil, err
+			}
+			object[string(key)] = converted
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported value of type %s", value.Type())
+	}
+}
+
+func fromStarlarkSequence(sequence starlark.Indexable) ([]any, error) {
+	items := make([]any, sequence.Len())
+	for i := range items {
+		converted, err := fromStarlark(sequence.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		items[i] = converted
+	}
+	return items, nil
+}
@@ -0,0 +1,138 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+func TestScriptExecutor(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "returns value from main",
+			config: `{"source":"def main(input):\n    return {\"total\": sum([i[\"n\"] for i in input[\"items\"]]), \"count\": len(input[\"items\"])}\n\ndef sum(xs):\n    t = 0\n    for x in xs:\n        t += x\n    return t\n"}`,
+			input:  `{"items":[{"n":1},{"n":2.5}]}`,
+			want:   `{"total":3.5,"count":2}`,
+		},
+		{
+			name:   "json and math modules are available",
+			config: `{"source":"def main(input):\n    return json.decode(json.encode(input)) + [math.floor(2.7)]\n"}`,
+			input:  `[1,"a",null,true]`,
+			want:   `[1,"a",null,true,2]`,
+		},
+		{
+			name:    "main is required",
+			config:  `{"source":"x = 1\n"}`,
+			input:   `{}`,
+			wantErr: "main(input)",
+		},
+		{
+			name:    "source is required",
+			config:  `{}`,
+			input:   `{}`,
+			wantErr: "source is required",
+		},
+		{
+			name:    "load is disabled",
+			config:  `{"source":"load(\"other.star\", \"x\")\ndef main(input):\n    return x\n"}`,
+			input:   `{}`,
+			wantErr: "load is not allowed",
+		},
+		{
+			name:    "runtime errors fail the node",
+			config:  `{"source":"def main(input):\n    return input[\"missing\"]\n"}`,
+			input:   `{}`,
+			wantErr: "script failed",
+		},
+		{
+			name:    "non-JSON return values are rejected",
+			config:  `{"source":"def main(input):\n    return main\n"}`,
+			input:   `{}`,
+			wantErr: "not JSON serializable",
+		},
+		{
+			name:    "non-string dict keys are rejected",
+			config:  `{"source":"def main(input):\n    return {1: 2}\n"}`,
+			input:   `{}`,
+			wantErr: "not a string",
+		},
+		{
+			name:    "step limit stops runaway loops",
+			config:  `{"source":"def main(input):\n    n = 0\n    for i in range(100000000):\n        n += i\n    return n\n","maxSteps":10000}`,
+			input:   `{}`,
+			wantErr: "too many steps",
+		},
+		{
+			name:    "timeout stops long scripts",
+			config:  `{"source":"def main(input):\n    n = 0\n    for i in range(1000000000):\n        n += i\n    return n\n","maxSteps":100000000000,"timeoutMs":50}`,
+			input:   `{}`,
+			wantErr: "timed out",
+		},
+		{
+			name:    "memory limit stops large allocations",
+			config:  `{"source":"def main(input):\n    xs = []\n    for i in range(100000000):\n        xs.append(str(i))\n    return len(xs)\n","maxSteps":100000000000,"maxMemoryBytes":8388608}`,
+			input:   `{}`,
+			wantErr: "memory limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewScriptExecutor().Execute(context.Background(), &outbound.NodeExecutionRequest{
+				Config: json.RawMessage(tt.config),
+				Input:  decodeJSON(t, tt.input),
+			})
+
+			if tt.wantErr != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, got output %v", tt.wantErr, result.Output)
+				}
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				if outbound.IsRetryableNodeExecutionError(err) {
+					t.Errorf("Expected script error to be permanent, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			got := decodeJSON(t, jsonKey(result.Output))
+			if want := decodeJSON(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("Expected %s, got %s", tt.want, jsonKey(result.Output))
+			}
+		})
+	}
+}
+
+func TestScriptExecutor_CallerCancellationIsRetryable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := NewScriptExecutor().Execute(ctx, &outbound.NodeExecutionRequest{
+		Config: json.RawMessage(`{"source":"def main(input):\n    n = 0\n    for i in range(1000000000):\n        n += i\n    return n\n","maxSteps":100000000000}`),
+		Input:  map[string]any{},
+	})
+	if err == nil {
+		t.Fatal("Expected error for cancelled script")
+	}
+	if strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Expected cancellation not to be reported as a timeout, got %v", err)
+	}
+	if !outbound.IsRetryableNodeExecutionError(err) {
+		t.Errorf("Expected cancellation to be retryable, got %v", err)
+	}
+}
@@ -0,0 +1,417 @@
+package outbound
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"text/template"
+	"time"
+
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+const defaultSendEmailTimeout = 30 * time.Second
+
+// SendEmailConfig describes the message. Subject, Text and HTML are Go
+// templates executed with the node input as data; Attachments are input paths
+// that hold NodeBinaryData.
+type SendEmailConfig struct {
+	To          []string `json:"to"`
+	Cc          []string `json:"cc"`
+	Bcc         []string `json:"bcc"`
+	Subject     string   `json:"subject"`
+	Text        string   `json:"text"`
+	HTML        string   `json:"html"`
+	Attachments []string `json:"attachments"`
+	TimeoutMs   int      `json:"timeoutMs"`
+}
+
+type SendEmailOutput struct {
+	MessageID  string   `json:"messageId"`
+	Recipients []string `json:"recipients"`
+}
+
+// SendEmailExecutor sends mail through the attached "smtp" credential. The
+// credential data holds host, port, username, password, from and tls, where
+// tls is "none", "starttls" (default) or "tls" for implicit TLS.
+type SendEmailExecutor struct {
+	idFactory id.Factory
+	rootCAs   *x509.CertPool
+}
+
+func NewSendEmailExecutor(idFactory id.Factory) *SendEmailExecutor {
+	return &SendEmailExecutor{idFactory: idFactory}
+}
+
+func (e *SendEmailExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config SendEmailConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	credential := request.Credential
+	if credential == nil || credential.Type != "smtp" {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("an smtp credential is required"))
+	}
+	host, from := credential.Data["host"], credential.Data["from"]
+	if host == "" || from == "" {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("smtp credential requires host and from"))
+	}
+	// smtp.PlainAuth only sends credentials over an unencrypted connection
+	// to localhost, so this could never succeed.
+	if credential.Data["username"] != "" && credential.Data["tls"] == "none" && !isLocalSMTPHost(host) {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("smtp credential with a username requires tls or starttls for host %s", host))
+	}
+
+	recipients := append(append(append([]string{}, config.To...), config.Cc...), config.Bcc...)
+	if len(recipients) == 0 {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("at least one recipient is required"))
+	}
+
+	messageID := fmt.Sprintf("<%s@%s>", e.idFactory.New(), host)
+	message, err := e.buildMessage(&config, from, messageID, request.Input)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+
+	timeout := defaultSendEmailTimeout
+	if config.TimeoutMs > 0 {
+		timeout = time.Duration(config.TimeoutMs) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := e.send(ctx, credential.Data, from, recipients, message); err != nil {
+		return nil, classifySMTPError(err)
+	}
+
+	return &outbound.NodeExecutionResult{
+		Output: &SendEmailOutput{
+			MessageID:  messageID,
+			Recipients: recipients,
+		},
+	}, nil
+}
+
+func (e *SendEmailExecutor) send(ctx context.Context, data map[string]string, from string, recipients []string, message []byte) error {
+	host := data["host"]
+	port := data["port"]
+	tlsMode := data["tls"]
+	if tlsMode == "" {
+		tlsMode = "starttls"
+	}
+	if port == "" {
+		port = map[string]string{"none": "25", "starttls": "587", "tls": "465"}[tlsMode]
+	}
+	tlsConfig := &tls.Config{ServerName: host, RootCAs: e.rootCAs}
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	switch tlsMode {
+	case "tls":
+		conn = tls.Client(conn, tlsConfig)
+	case "starttls", "none":
+	default:
+		conn.Close()
+		return outbound.NewPermanentNodeExecutionError(fmt.Errorf("unsupported tls mode %q", tlsMode))
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if tlsMode == "starttls" {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return outbound.NewPermanentNodeExecutionError(fmt.Errorf("smtp server does not support STARTTLS"))
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+
+	if username := data["username"]; username != "" {
+		if err := client.Auth(smtp.PlainAuth("", username, data["password"], host)); err != nil {
+			return fmt.Errorf("smtp authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("smtp MAIL FROM failed: %w", err)
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("smtp RCPT TO %s failed: %w", recipient, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, err := w.Write(message); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp server rejected message: %w", err)
+	}
+
+	// The server accepted the message once DATA succeeded. Failing here would
+	// make the step retry and send a duplicate.
+	if err := client.Quit(); err != nil {
+		log.Printf("smtp QUIT failed after message was accepted: %v", err)
+	}
+	return nil
+}
+
+func (e *SendEmailExecutor) buildMessage(config *SendEmailConfig, from string, messageID string, input any) ([]byte, error) {
+	subject, err := renderTextTemplate("subject", config.Subject, input)
+	if err != nil {
+		return nil, err
+	}
+	text, err := renderTextTemplate("text", config.Text, input)
+	if err != nil {
+		return nil, err
+	}
+	html, err := renderHTMLTemplate(config.HTML, input)
+	if err != nil {
+		return nil, err
+	}
+	if text == "" && html == "" {
+		return nil, fmt.Errorf("text or html body is required")
+	}
+
+	attachments, err := emailAttachments(config.Attachments, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	header := textproto.MIMEHeader{}
+	header.Set("From", from)
+	header.Set("To", strings.Join(config.To, ", "))
+	if len(config.Cc) > 0 {
+		header.Set("Cc", strings.Join(config.Cc, ", "))
+	}
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", subject))
+	header.Set("Date", time.Now().UTC().Format(time.RFC1123Z))
+	header.Set("Message-ID", messageID)
+	header.Set("MIME-Version", "1.0")
+
+	bodyHeader, body, err := emailBodyPart(text, html)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attachments) == 0 {
+		for name, values := range bodyHeader {
+			header[name] = values
+		}
+		writeMIMEHeader(&buf, header)
+		buf.Write(body)
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	header.Set("Content-Type", "multipart/mixed; boundary="+mixed.Boundary())
+	writeMIMEHeader(&buf, header)
+
+	part, err := mixed.CreatePart(bodyHeader)
+	if err != nil {
+		return nil, err
+	}
+	part.Write(body)
+
+	for _, attachment := range attachments {
+		mimeType := attachment.MimeType
+		if mimeType == "" {
+			mimeType = "application/octet-stream"
+		}
+		part, err := mixed.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mimeType},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		writeBase64Lines(part, attachment.Data)
+	}
+
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// emailBodyPart renders a text, html or multipart/alternative body and the
+// headers describing it.
+func emailBodyPart(text, html string) (textproto.MIMEHeader, []byte, error) {
+	var buf bytes.Buffer
+
+	if text == "" || html == "" {
+		contentType, content := "text/plain; charset=utf-8", text
+		if html != "" {
+			contentType, content = "text/html; charset=utf-8", html
+		}
+		if err := writeQuotedPrintable(&buf, content); err != nil {
+			return nil, nil, err
+		}
+		return textproto.MIMEHeader{
+			"Content-Type":              {contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		}, buf.Bytes(), nil
+	}
+
+	alternative := multipart.NewWriter(&buf)
+	for _, body := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", html},
+	} {
+		part, err := alternative.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {body.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := writeQuotedPrintable(part, body.content); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := alternative.Close(); err != nil {
+		return nil, nil, err
+	}
+	return textproto.MIMEHeader{
+		"Content-Type": {"multipart/alternative; boundary=" + alternative.Boundary()},
+	}, buf.Bytes(), nil
+}
+
+func writeMIMEHeader(w io.Writer, header textproto.MIMEHeader) {
+	for _, name := range []string{"From", "To", "Cc", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Content-Transfer-Encoding"} {
+		if value := header.Get(name); value != "" {
+			fmt.Fprintf(w, "%s: %s\r\n", name, value)
+		}
+	}
+	io.WriteString(w, "\r\n")
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func writeBase64Lines(w io.Writer, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		io.WriteString(w, encoded[:76]+"\r\n")
+		encoded = encoded[76:]
+	}
+	io.WriteString(w, encoded+"\r\n")
+}
+
+func renderTextTemplate(name, source string, data any) (string, error) {
+	if source == "" {
+		return "", nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+func renderHTMLTemplate(source string, data any) (string, error) {
+	if source == "" {
+		return "", nil
+	}
+	tmpl, err := htmltemplate.New("html").Option("missingkey=zero").Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("invalid html template: %w", err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render html template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func emailAttachments(paths []string, input any) ([]*outbound.NodeBinaryData, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	cloned, err := cloneJSON(input)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*outbound.NodeBinaryData, 0, len(paths))
+	for _, path := range paths {
+		value, ok := getPath(cloned, path)
+		if !ok {
+			return nil, fmt.Errorf("attachment %q not found in input", path)
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attachment %q: %w", path, err)
+		}
+		attachment := &outbound.NodeBinaryData{}
+		if err := json.Unmarshal(raw, attachment); err != nil || attachment.FileName == "" {
+			return nil, fmt.Errorf("attachment %q is not binary data", path)
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, nil
+}
+
+// isLocalSMTPHost matches the hosts smtp.PlainAuth accepts without TLS.
+func isLocalSMTPHost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// classifySMTPError treats 5xx replies as permanent and everything else,
+// including 4xx replies and network errors, as retryable.
+func classifySMTPError(err error) error {
+	var executionErr *outbound.NodeExecutionError
+	if errors.As(err, &executionErr) {
+		return err
+	}
+	var protocolErr *textproto.Error
+	if errors.As(err, &protocolErr) && protocolErr.Code >= 500 {
+		return outbound.NewPermanentNodeExecutionError(err)
+	}
+	return outbound.NewRetryableNodeExecutionError(err)
+}
@@ -0,0 +1,478 @@
+package outbound
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+// fakeSMTPServer is an in-process SMTP stand-in that accepts STARTTLS,
+// AUTH PLAIN and DATA and records what it received.
+type fakeSMTPServer struct {
+	listener    net.Listener
+	tlsConfig   *tls.Config
+	rcptReply   string
+	quitReply   string
+	mu          sync.Mutex
+	received    []*fakeSMTPMessage
+	connections sync.WaitGroup
+}
+
+type fakeSMTPMessage struct {
+	auth string
+	tls  bool
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTPServer(t *testing.T, implicitTLS bool) (*fakeSMTPServer, *x509.CertPool) {
+	t.Helper()
+
+	certificate, pool := newTestCertificate(t)
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{certificate}}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	if implicitTLS {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	server := &fakeSMTPServer{listener: listener, tlsConfig: tlsConfig}
+	go server.serve()
+	t.Cleanup(func() {
+		listener.Close()
+		server.connections.Wait()
+	})
+	return server, pool
+}
+
+func (s *fakeSMTPServer) port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+func (s *fakeSMTPServer) messages() []*fakeSMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.received
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.connections.Add(1)
+		go func() {
+			defer s.connections.Done()
+			defer conn.Close()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	_, isTLS := conn.(*tls.Conn)
+	reader := textproto.NewReader(bufio.NewReader(conn))
+	reply := func(line string) { fmt.Fprintf(conn, "%s\r\n", line) }
+	message := &fakeSMTPMessage{tls: isTLS}
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadLine()
+		if err != nil {
+			return
+		}
+		command, argument, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(command) {
+		case "EHLO":
+			reply("250-localhost")
+			if !message.tls {
+				reply("250-STARTTLS")
+			}
+			reply("250 AUTH PLAIN")
+		case "STARTTLS":
+			reply("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			reader = textproto.NewReader(bufio.NewReader(conn))
+			message.tls = true
+		case "AUTH":
+			_, credentials, _ := strings.Cut(argument, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(credentials)
+			message.auth = string(decoded)
+			reply("235 Authentication successful")
+		case "MAIL":
+			message.from = strings.Trim(strings.TrimPrefix(argument, "FROM:"), "<>")
+			reply("250 OK")
+		case "RCPT":
+			if s.rcptReply != "" {
+				reply(s.rcptReply)
+				continue
+			}
+			message.to = append(message.to, strings.Trim(strings.TrimPrefix(argument, "TO:"), "<>"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data, err := reader.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			message.data = string(data)
+			s.mu.Lock()
+			s.received = append(s.received, message)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			if s.quitReply != "" {
+				reply(s.quitReply)
+				return
+			}
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func newTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(parsed)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func sendEmail(t *testing.T, server *fakeSMTPServer, rootCAs *x509.CertPool, tlsMode string, config map[string]any, input any) (*SendEmailOutput, error) {
+	t.Helper()
+
+	raw, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+
+	executor := NewSendEmailExecutor(id.NewULIDFactory())
+	executor.rootCAs = rootCAs
+
+	result, err := executor.Execute(context.Background(), &outbound.NodeExecutionRequest{
+		Config: raw,
+		Input:  input,
+		Credential: &outbound.NodeCredential{
+			Type: "smtp",
+			Data: map[string]string{
+				"host":     "127.0.0.1",
+				"port":     server.port(),
+				"username": "mailer",
+				"password": "secret",
+				"from":     "noreply@example.com",
+				"tls":      tlsMode,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Output.(*SendEmailOutput), nil
+}
+
+// readEmailParts returns the decoded leaf parts of a message keyed by
+// content type, or by file name for attachments.
+func readEmailParts(t *testing.T, data string) (*mail.Message, map[string]string) {
+	t.Helper()
+
+	message, err := mail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to parse message: %v", err)
+	}
+
+	parts := make(map[string]string)
+	var walk func(header textproto.MIMEHeader, body io.Reader)
+	walk = func(header textproto.MIMEHeader, body io.Reader) {
+		mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+		if err != nil {
+			t.Fatalf("Invalid content type %q: %v", header.Get("Content-Type"), err)
+		}
+		if strings.HasPrefix(mediaType, "multipart/") {
+			reader := multipart.NewReader(body, params["boundary"])
+			for {
+				part, err := reader.NextRawPart()
+				if err == io.EOF {
+					return
+				}
+				if err != nil {
+					t.Fatalf("Failed to read part: %v", err)
+				}
+				walk(part.Header, part)
+			}
+		}
+
+		switch header.Get("Content-Transfer-Encoding") {
+		case "quoted-printable":
+			body = quotedprintable.NewReader(body)
+		case "base64":
+			body = base64.NewDecoder(base64.StdEncoding, body)
+		}
+		content, err := io.ReadAll(body)
+		if err != nil {
+			t.Fatalf("Failed to read body: %v", err)
+		}
+
+		key := mediaType
+		if _, params, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil {
+			key = params["filename"]
+		}
+		parts[key] = string(content)
+	}
+	walk(textproto.MIMEHeader(message.Header), message.Body)
+
+	return message, parts
+}
+
+func TestSendEmailExecutor_SendsTemplatedMessageWithAttachmentOverStartTLS(t *testing.T) {
+	server, rootCAs := newFakeSMTPServer(t, false)
+
+	input := map[string]any{
+		"customer": map[string]any{"name": "Ada <Admin>"},
+		"invoice":  &outbound.NodeBinaryData{FileName: "invoice.pdf", MimeType: "application/pdf", Data: []byte("%PDF-1.7")},
+	}
+	output, err := sendEmail(t, server, rootCAs, "starttls", map[string]any{
+		"to":          []string{"ada@example.com"},
+		"bcc":         []string{"audit@example.com"},
+		"subject":     "Invoice for {{.customer.name}} ✓",
+		"text":        "Hello {{.customer.name}}",
+		"html":        "<p>Hello {{.customer.name}}</p>",
+		"attachments": []string{"invoice"},
+	}, input)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	messages := server.messages()
+	if len(messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(messages))
+	}
+	received := messages[0]
+	if !received.tls {
+		t.Error("Expected message to be sent over TLS")
+	}
+	if received.auth != "\x00mailer\x00secret" {
+		t.Errorf("Expected PLAIN auth for mailer, got %q", received.auth)
+	}
+	if received.from != "noreply@example.com" {
+		t.Errorf("Expected envelope sender noreply@example.com, got %q", received.from)
+	}
+	if strings.Join(received.to, ",") != "ada@example.com,audit@example.com" {
+		t.Errorf("Expected envelope recipients to include bcc, got %v", received.to)
+	}
+	if strings.Join(output.Recipients, ",") != "ada@example.com,audit@example.com" {
+		t.Errorf("Unexpected output recipients %v", output.Recipients)
+	}
+
+	message, parts := readEmailParts(t, received.data)
+	subject, _ := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	if subject != "Invoice for Ada <Admin> ✓" {
+		t.Errorf("Unexpected subject %q", subject)
+	}
+	if message.Header.Get("Bcc") != "" {
+		t.Error("Bcc must not appear in headers")
+	}
+	if message.Header.Get("Message-ID") != output.MessageID {
+		t.Errorf("Expected Message-ID %q, got %q", output.MessageID, message.Header.Get("Message-ID"))
+	}
+	if parts["text/plain"] != "Hello Ada <Admin>" {
+		t.Errorf("Unexpected text part %q", parts["text/plain"])
+	}
+	if parts["text/html"] != "<p>Hello Ada &lt;Admin&gt;</p>" {
+		t.Errorf("Expected escaped html part, got %q", parts["text/html"])
+	}
+	if parts["invoice.pdf"] != "%PDF-1.7" {
+		t.Errorf("Unexpected attachment content %q", parts["invoice.pdf"])
+	}
+}
+
+func TestSendEmailExecutor_TLSModes(t *testing.T) {
+	tests := []struct {
+		mode        string
+		implicitTLS bool
+		wantTLS     bool
+	}{
+		{mode: "tls", implicitTLS: true, wantTLS: true},
+		{mode: "none", implicitTLS: false, wantTLS: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			server, rootCAs := newFakeSMTPServer(t, tt.implicitTLS)
+
+			_, err := sendEmail(t, server, rootCAs, tt.mode, map[string]any{
+				"to":      []string{"ada@example.com"},
+				"subject": "Hi",
+				"text":    "Hello",
+			}, nil)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			messages := server.messages()
+			if len(messages) != 1 || messages[0].tls != tt.wantTLS {
+				t.Fatalf("Expected 1 message with tls=%v, got %+v", tt.wantTLS, messages)
+			}
+			// A single-part body keeps the line break of the DATA terminator.
+			_, parts := readEmailParts(t, messages[0].data)
+			if strings.TrimRight(parts["text/plain"], "\r\n") != "Hello" {
+				t.Errorf("Unexpected text part %q", parts["text/plain"])
+			}
+		})
+	}
+}
+
+func TestSendEmailExecutor_MapsRejectionsToRetryability(t *testing.T) {
+	tests := []struct {
+		reply         string
+		wantRetryable bool
+	}{
+		{"550 Mailbox unavailable", false},
+		{"451 Try again later", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reply, func(t *testing.T) {
+			server, rootCAs := newFakeSMTPServer(t, false)
+			server.rcptReply = tt.reply
+
+			_, err := sendEmail(t, server, rootCAs, "starttls", map[string]any{
+				"to":   []string{"ada@example.com"},
+				"text": "Hello",
+			}, nil)
+			if err == nil {
+				t.Fatal("Expected error for rejected recipient")
+			}
+			if got := outbound.IsRetryableNodeExecutionError(err); got != tt.wantRetryable {
+				t.Errorf("Expected retryable=%v, got %v (%v)", tt.wantRetryable, got, err)
+			}
+		})
+	}
+}
+
+func TestSendEmailExecutor_IgnoresQuitFailureAfterDelivery(t *testing.T) {
+	server, rootCAs := newFakeSMTPServer(t, false)
+	server.quitReply = "421 Service closing"
+
+	if _, err := sendEmail(t, server, rootCAs, "starttls", map[string]any{
+		"to":   []string{"ada@example.com"},
+		"text": "Hello",
+	}, nil); err != nil {
+		t.Fatalf("Expected no error after message was accepted, got %v", err)
+	}
+	if got := len(server.messages()); got != 1 {
+		t.Errorf("Expected 1 message, got %d", got)
+	}
+}
+
+func TestSendEmailExecutor_RejectsInvalidRequests(t *testing.T) {
+	server, rootCAs := newFakeSMTPServer(t, false)
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		input  any
+	}{
+		{"no recipients", map[string]any{"text": "Hello"}, nil},
+		{"no body", map[string]any{"to": []string{"a@example.com"}}, nil},
+		{"invalid template", map[string]any{"to": []string{"a@example.com"}, "text": "{{.x"}, nil},
+		{"missing attachment", map[string]any{"to": []string{"a@example.com"}, "text": "x", "attachments": []string{"file"}}, map[string]any{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sendEmail(t, server, rootCAs, "starttls", tt.config, tt.input)
+			if err == nil {
+				t.Fatal("Expected error")
+			}
+			if outbound.IsRetryableNodeExecutionError(err) {
+				t.Errorf("Expected permanent error, got %v", err)
+			}
+		})
+	}
+
+	if len(server.messages()) != 0 {
+		t.Errorf("Expected no messages to be sent, got %d", len(server.messages()))
+	}
+}
+
+func TestSendEmailExecutor_RejectsAuthWithoutTLSToRemoteHost(t *testing.T) {
+	_, err := NewSendEmailExecutor(id.NewULIDFactory()).Execute(context.Background(), &outbound.NodeExecutionRequest{
+		Config: json.RawMessage(`{"to":["ada@example.com"],"text":"Hello"}`),
+		Credential: &outbound.NodeCredential{
+			Type: "smtp",
+			Data: map[string]string{
+				"host":     "smtp.example.com",
+				"username": "mailer",
+				"password": "secret",
+				"from":     "noreply@example.com",
+				"tls":      "none",
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("Expected error for credentials over an unencrypted connection")
+	}
+	if outbound.IsRetryableNodeExecutionError(err) {
+		t.Errorf("Expected permanent error, got %v", err)
+	}
+}
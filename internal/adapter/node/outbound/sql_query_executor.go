@@ -0,0 +1,228 @@
+package outbound
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+const (
+	defaultSQLQueryTimeout = 30 * time.Second
+	defaultSQLQueryMaxRows = 1000
+
+	// Pool limits per connection string, so a busy workflow cannot exhaust
+	// the connections of a customer database.
+	sqlQueryMaxOpenConns    = 5
+	sqlQueryMaxIdleConns    = 2
+	sqlQueryConnMaxIdleTime = 5 * time.Minute
+)
+
+// SQLQueryConfig holds a parameterized query; Parameters are bound to $1, $2,
+// ... and are already resolved from expressions by the engine.
+type SQLQueryConfig struct {
+	Query      string `json:"query"`
+	Parameters []any  `json:"parameters"`
+	MaxRows    int    `json:"maxRows"`
+	TimeoutMs  int    `json:"timeoutMs"`
+}
+
+// SQLQueryExecutor runs a query against the database of the attached
+// "postgres" credential and returns the rows as objects keyed by column name.
+// bytea values are returned as NodeBinaryData. The statement runs in a
+// transaction that is rolled back when it returns more than MaxRows rows, so
+// a capped INSERT ... RETURNING leaves no changes behind. Connection pools are
+// kept per connection string until Close.
+type SQLQueryExecutor struct {
+	driverName string
+
+	mu  sync.Mutex
+	dbs map[string]*sql.DB
+}
+
+func NewSQLQueryExecutor() *SQLQueryExecutor {
+	return newSQLQueryExecutor("pgx")
+}
+
+func newSQLQueryExecutor(driverName string) *SQLQueryExecutor {
+	return &SQLQueryExecutor{
+		driverName: driverName,
+		dbs:        make(map[string]*sql.DB),
+	}
+}
+
+func (e *SQLQueryExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config SQLQueryConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+	if config.Query == "" {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("query is required"))
+	}
+	if request.Credential == nil || request.Credential.Type != "postgres" || request.Credential.Data["connectionString"] == "" {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("a postgres credential with a connectionString is required"))
+	}
+
+	db, err := e.db(request.Credential.Data["connectionString"])
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+
+	timeout := defaultSQLQueryTimeout
+	if config.TimeoutMs > 0 {
+		timeout = time.Duration(config.TimeoutMs) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	maxRows := defaultSQLQueryMaxRows
+	if config.MaxRows > 0 {
+		maxRows = config.MaxRows
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, classifySQLError(fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, config.Query, config.Parameters...)
+	if err != nil {
+		return nil, classifySQLError(fmt.Errorf("failed to execute query: %w", err))
+	}
+	result, err := readSQLRows(rows, maxRows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, classifySQLError(fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	return &outbound.NodeExecutionResult{Output: result}, nil
+}
+
+// readSQLRows returns the rows as objects keyed by column name and closes
+// rows. It fails once there are more than maxRows rows.
+func readSQLRows(rows *sql.Rows, maxRows int) ([]any, error) {
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, classifySQLError(fmt.Errorf("failed to read columns: %w", err))
+	}
+	columns := make([]string, len(columnTypes))
+	for i, columnType := range columnTypes {
+		columns[i] = columnType.Name()
+	}
+
+	result := make([]any, 0)
+	for rows.Next() {
+		if len(result) == maxRows {
+			return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("query returned more than %d rows", maxRows))
+		}
+
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, classifySQLError(fmt.Errorf("failed to scan row: %w", err))
+		}
+
+		row := make(map[string]any, len(columns))
+		for i, column := range columns {
+			if b, ok := values[i].([]byte); ok {
+				row[column] = sqlBytesValue(columnTypes[i].DatabaseTypeName(), b)
+				continue
+			}
+			row[column] = values[i]
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, classifySQLError(fmt.Errorf("row iteration error: %w", err))
+	}
+
+	return result, nil
+}
+
+func (e *SQLQueryExecutor) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var errs []error
+	for dsn, db := range e.dbs {
+		errs = append(errs, db.Close())
+		delete(e.dbs, dsn)
+	}
+	return errors.Join(errs...)
+}
+
+func (e *SQLQueryExecutor) db(dsn string) (*sql.DB, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if db, ok := e.dbs[dsn]; ok {
+		return db, nil
+	}
+	db, err := sql.Open(e.driverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	db.SetMaxOpenConns(sqlQueryMaxOpenConns)
+	db.SetMaxIdleConns(sqlQueryMaxIdleConns)
+	db.SetConnMaxIdleTime(sqlQueryConnMaxIdleTime)
+	e.dbs[dsn] = db
+	return db, nil
+}
+
+// sqlBytesValue converts a column scanned as bytes. Only bytea holds binary
+// data; json, xml and other text types arrive as bytes too and stay strings.
+func sqlBytesValue(databaseTypeName string, b []byte) any {
+	if databaseTypeName == "BYTEA" {
+		return &outbound.NodeBinaryData{
+			MimeType: "application/octet-stream",
+			Data:     bytes.Clone(b),
+		}
+	}
+	return string(b)
+}
+
+// classifySQLError treats errors reported by the database server (syntax,
+// constraint, permission, ...) as permanent, except for the transient ones
+// in retryableSQLState. Everything else, such as connection failures and
+// timeouts, is retryable.
+func classifySQLError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && !retryableSQLState(pgErr.Code) {
+		return outbound.NewPermanentNodeExecutionError(err)
+	}
+	return outbound.NewRetryableNodeExecutionError(err)
+}
+
+// retryableSQLState reports whether a server error with SQLSTATE code may
+// succeed when the statement is run again.
+func retryableSQLState(code string) bool {
+	switch code {
+	case "40001", // serialization_failure
+		"40P01", // deadlock_detected
+		"53300", // too_many_connections
+		"55P03", // lock_not_available
+		"57P01", // admin_shutdown
+		"57P02", // crash_shutdown
+		"57P03": // cannot_connect_now
+		return true
+	}
+	// Class 08: connection exceptions.
+	return strings.HasPrefix(code, "08")
+}
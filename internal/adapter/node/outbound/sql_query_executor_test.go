@@ -0,0 +1,246 @@
+package outbound
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+// fakeSQLDriver serves fixed results per DSN, records the last query and
+// counts how transactions ended.
+type fakeSQLDriver struct{}
+
+type fakeSQLScenario struct {
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+	err     error
+	block   bool
+
+	commits   int
+	rollbacks int
+}
+
+var (
+	fakeSQLScenarios = map[string]*fakeSQLScenario{}
+	fakeSQLLastQuery string
+	fakeSQLLastArgs  []any
+)
+
+func init() {
+	sql.Register("fakesql", fakeSQLDriver{})
+}
+
+func (fakeSQLDriver) Open(dsn string) (driver.Conn, error) {
+	scenario, ok := fakeSQLScenarios[dsn]
+	if !ok {
+		return nil, errors.New("unknown dsn " + dsn)
+	}
+	return &fakeSQLConn{scenario: scenario}, nil
+}
+
+type fakeSQLConn struct {
+	scenario *fakeSQLScenario
+}
+
+func (c *fakeSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *fakeSQLConn) Close() error { return nil }
+func (c *fakeSQLConn) Begin() (driver.Tx, error) {
+	return &fakeSQLTx{scenario: c.scenario}, nil
+}
+
+func (c *fakeSQLConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	fakeSQLLastQuery = query
+	fakeSQLLastArgs = nil
+	for _, arg := range args {
+		fakeSQLLastArgs = append(fakeSQLLastArgs, arg.Value)
+	}
+
+	if c.scenario.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if c.scenario.err != nil {
+		return nil, c.scenario.err
+	}
+	return &fakeSQLRows{columns: c.scenario.columns, types: c.scenario.types, rows: c.scenario.rows}, nil
+}
+
+type fakeSQLTx struct {
+	scenario *fakeSQLScenario
+}
+
+func (tx *fakeSQLTx) Commit() error {
+	tx.scenario.commits++
+	return nil
+}
+
+func (tx *fakeSQLTx) Rollback() error {
+	tx.scenario.rollbacks++
+	return nil
+}
+
+type fakeSQLRows struct {
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (r *fakeSQLRows) ColumnTypeDatabaseTypeName(index int) string {
+	if index < len(r.types) {
+		return r.types[index]
+	}
+	return ""
+}
+
+func (r *fakeSQLRows) Columns() []string { return r.columns }
+func (r *fakeSQLRows) Close() error      { return nil }
+
+func (r *fakeSQLRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+func executeSQLQuery(t *testing.T, dsn string, config string) (any, error) {
+	t.Helper()
+
+	executor := newSQLQueryExecutor("fakesql")
+	defer executor.Close()
+
+	result, err := executor.Execute(context.Background(), &outbound.NodeExecutionRequest{
+		Config:     json.RawMessage(config),
+		Credential: &outbound.NodeCredential{Type: "postgres", Data: map[string]string{"connectionString": dsn}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Output, nil
+}
+
+func TestSQLQueryExecutor_ReturnsRowsAsObjects(t *testing.T) {
+	fakeSQLScenarios["rows"] = &fakeSQLScenario{
+		columns: []string{"id", "email", "payload", "avatar"},
+		types:   []string{"INT8", "TEXT", "JSONB", "BYTEA"},
+		rows: [][]driver.Value{
+			{int64(1), "a@example.com", []byte(`{"x":1}`), []byte{0xff, 0x00, 0xd8}},
+			{int64(2), nil, nil, nil},
+		},
+	}
+
+	output, err := executeSQLQuery(t, "rows", `{"query":"SELECT id, email, payload FROM contact WHERE team = $1 AND active = $2","parameters":["sales",true]}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := []any{
+		map[string]any{
+			"id":      int64(1),
+			"email":   "a@example.com",
+			"payload": `{"x":1}`,
+			"avatar":  &outbound.NodeBinaryData{MimeType: "application/octet-stream", Data: []byte{0xff, 0x00, 0xd8}},
+		},
+		map[string]any{"id": int64(2), "email": nil, "payload": nil, "avatar": nil},
+	}
+	if !reflect.DeepEqual(output, want) {
+		t.Errorf("Expected %v, got %v", want, output)
+	}
+	if fakeSQLLastQuery != "SELECT id, email, payload FROM contact WHERE team = $1 AND active = $2" {
+		t.Errorf("Unexpected query %q", fakeSQLLastQuery)
+	}
+	if !reflect.DeepEqual(fakeSQLLastArgs, []any{"sales", true}) {
+		t.Errorf("Expected bound parameters [sales true], got %v", fakeSQLLastArgs)
+	}
+}
+
+func TestSQLQueryExecutor_EnforcesRowCap(t *testing.T) {
+	fakeSQLScenarios["many"] = &fakeSQLScenario{
+		columns: []string{"n"},
+		rows:    [][]driver.Value{{int64(1)}, {int64(2)}, {int64(3)}},
+	}
+
+	if _, err := executeSQLQuery(t, "many", `{"query":"SELECT n FROM t","maxRows":3}`); err != nil {
+		t.Fatalf("Expected rows within cap to succeed, got %v", err)
+	}
+	if scenario := fakeSQLScenarios["many"]; scenario.commits != 1 || scenario.rollbacks != 0 {
+		t.Fatalf("Expected the statement to be committed, got %d commits and %d rollbacks", scenario.commits, scenario.rollbacks)
+	}
+
+	_, err := executeSQLQuery(t, "many", `{"query":"INSERT INTO t (n) SELECT generate_series(1, 3) RETURNING n","maxRows":2}`)
+	if err == nil {
+		t.Fatal("Expected error when exceeding row cap")
+	}
+	if outbound.IsRetryableNodeExecutionError(err) {
+		t.Errorf("Expected row cap error to be permanent, got %v", err)
+	}
+	if scenario := fakeSQLScenarios["many"]; scenario.commits != 1 || scenario.rollbacks != 1 {
+		t.Errorf("Expected the capped statement to be rolled back, got %d commits and %d rollbacks", scenario.commits, scenario.rollbacks)
+	}
+}
+
+func TestSQLQueryExecutor_EnforcesStatementTimeout(t *testing.T) {
+	fakeSQLScenarios["slow"] = &fakeSQLScenario{block: true}
+
+	_, err := executeSQLQuery(t, "slow", `{"query":"SELECT pg_sleep(10)","timeoutMs":50}`)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+	if !outbound.IsRetryableNodeExecutionError(err) {
+		t.Errorf("Expected timeout to be retryable, got %v", err)
+	}
+}
+
+func TestSQLQueryExecutor_ClassifiesServerErrors(t *testing.T) {
+	tests := []struct {
+		code          string
+		wantRetryable bool
+	}{
+		{"42601", false}, // syntax_error
+		{"23505", false}, // unique_violation
+		{"42501", false}, // insufficient_privilege
+		{"40001", true},  // serialization_failure
+		{"40P01", true},  // deadlock_detected
+		{"53300", true},  // too_many_connections
+		{"55P03", true},  // lock_not_available
+		{"57P01", true},  // admin_shutdown
+		{"08006", true},  // connection_failure
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			dsn := "error-" + tt.code
+			fakeSQLScenarios[dsn] = &fakeSQLScenario{err: &pgconn.PgError{Code: tt.code, Message: "server error"}}
+
+			_, err := executeSQLQuery(t, dsn, `{"query":"SELECT 1"}`)
+			if err == nil {
+				t.Fatal("Expected server error")
+			}
+			if got := outbound.IsRetryableNodeExecutionError(err); got != tt.wantRetryable {
+				t.Errorf("Expected retryable=%v, got %v (%v)", tt.wantRetryable, got, err)
+			}
+		})
+	}
+}
+
+func TestSQLQueryExecutor_RequiresCredential(t *testing.T) {
+	_, err := newSQLQueryExecutor("fakesql").Execute(context.Background(), &outbound.NodeExecutionRequest{
+		Config: json.RawMessage(`{"query":"SELECT 1"}`),
+	})
+	if err == nil {
+		t.Fatal("Expected error without credential")
+	}
+}
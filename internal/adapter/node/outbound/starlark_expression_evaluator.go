@@ -0,0 +1,107 @@
+package outbound
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	starlarkjson "go.starlark.net/lib/json"
+	starlarkmath "go.starlark.net/lib/math"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+const defaultExpressionMaxSteps = 100_000
+
+// StarlarkExpressionEvaluator evaluates an expression as a single Starlark
+// expression, e.g. `item["price"] * 2` or `item["status"] == "active"`. The
+// scope entries and the json and math modules are predeclared. Results are
+// returned as JSON values, so numbers are always float64.
+type StarlarkExpressionEvaluator struct {
+	maxSteps uint64
+}
+
+func NewStarlarkExpressionEvaluator() *StarlarkExpressionEvaluator {
+	return &StarlarkExpressionEvaluator{maxSteps: defaultExpressionMaxSteps}
+}
+
+func (e *StarlarkExpressionEvaluator) Evaluate(expression string, scope map[string]any) (any, error) {
+	return e.evaluate(expression, nil, scope)
+}
+
+// Bind converts scope to Starlark values once. The bound values are frozen,
+// so one expression cannot change what the next one sees.
+func (e *StarlarkExpressionEvaluator) Bind(scope map[string]any) (outbound.BoundExpressionEvaluator, error) {
+	globals, err := starlarkScope(scope)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range globals {
+		value.Freeze()
+	}
+	return &boundStarlarkExpressionEvaluator{evaluator: e, globals: globals}, nil
+}
+
+func (e *StarlarkExpressionEvaluator) evaluate(expression string, globals starlark.StringDict, scope map[string]any) (any, error) {
+	locals, err := starlarkScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	predeclared := starlark.StringDict{
+		"json": starlarkjson.Module,
+		"math": starlarkmath.Module,
+	}
+	maps.Copy(predeclared, globals)
+	maps.Copy(predeclared, locals)
+
+	thread := &starlark.Thread{
+		Name:  "expression",
+		Print: func(*starlark.Thread, string) {},
+		Load: func(*starlark.Thread, string) (starlark.StringDict, error) {
+			return nil, errors.New("load is not allowed in expressions")
+		},
+	}
+	thread.SetMaxExecutionSteps(e.maxSteps)
+
+	result, err := starlark.EvalOptions(&syntax.FileOptions{}, thread, "expression", expression, predeclared)
+	if err != nil {
+		return nil, fmt.Errorf("expression %q failed: %w", expression, err)
+	}
+
+	value, err := fromStarlark(result)
+	if err != nil {
+		return nil, fmt.Errorf("expression %q returned a value that is not JSON serializable: %w", expression, err)
+	}
+	return cloneJSON(value)
+}
+
+type boundStarlarkExpressionEvaluator struct {
+	evaluator *StarlarkExpressionEvaluator
+	globals   starlark.StringDict
+}
+
+func (b *boundStarlarkExpressionEvaluator) Evaluate(expression string, scope map[string]any) (any, error) {
+	return b.evaluator.evaluate(expression, b.globals, scope)
+}
+
+// starlarkScope converts scope to Starlark values. A nil scope is empty.
+func starlarkScope(scope map[string]any) (starlark.StringDict, error) {
+	// Round-trip through JSON so scope values are plain JSON types.
+	cloned, err := cloneJSON(scope)
+	if err != nil {
+		return nil, err
+	}
+	object, _ := cloned.(map[string]any)
+
+	converted := make(starlark.StringDict, len(object))
+	for name, value := range object {
+		v, err := toStarlark(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scope value %s: %w", name, err)
+		}
+		converted[name] = v
+	}
+	return converted, nil
+}
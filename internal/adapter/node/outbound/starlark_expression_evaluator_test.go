@@ -0,0 +1,75 @@
+package outbound
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStarlarkExpressionEvaluator(t *testing.T) {
+	scope := map[string]any{
+		"item":  map[string]any{"name": "Ada", "price": 2.5, "tags": []any{"a", "b"}},
+		"index": 3,
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       any
+		wantErr    bool
+	}{
+		{"field access", `item["name"]`, "Ada", false},
+		{"arithmetic", `item["price"] * 2`, float64(5), false},
+		{"int scope value", `index + 1`, float64(4), false},
+		{"comparison", `item["price"] > 2 and len(item["tags"]) == 2`, true, false},
+		{"builds objects", `{"upper": item["name"].upper()}`, map[string]any{"upper": "ADA"}, false},
+		{"math module", `math.floor(item["price"])`, float64(2), false},
+		{"unknown name", `missing`, nil, true},
+		{"statements are rejected", `x = 1`, nil, true},
+		{"step limit", `[i for i in range(1000000)]`, nil, true},
+	}
+
+	evaluator := NewStarlarkExpressionEvaluator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evaluator.Evaluate(tt.expression, scope)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Evaluate(%q) error = %v, wantErr %v", tt.expression, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate(%q) = %#v, want %#v", tt.expression, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStarlarkExpressionEvaluator_NilScope(t *testing.T) {
+	got, err := NewStarlarkExpressionEvaluator().Evaluate("1 + 1", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got != float64(2) {
+		t.Errorf("Expected 2, got %#v", got)
+	}
+}
+
+func TestStarlarkExpressionEvaluator_Bind(t *testing.T) {
+	bound, err := NewStarlarkExpressionEvaluator().Bind(map[string]any{
+		"input": map[string]any{"min": 2, "seen": []any{}},
+		"item":  "bound",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	got, err := bound.Evaluate(`item > input["min"]`, map[string]any{"item": 3})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got != true {
+		t.Errorf("Expected call scope to win over bound scope, got %#v", got)
+	}
+
+	if _, err := bound.Evaluate(`input["seen"].append(1)`, nil); err == nil {
+		t.Error("Expected bound values to be frozen")
+	}
+}
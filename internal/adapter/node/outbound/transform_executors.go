@@ -0,0 +1,564 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+)
+
+// SetFieldsExecutor sets fields on the input object. Values are taken as-is;
+// expressions in them are resolved by the engine before execution.
+type SetFieldsExecutor struct{}
+
+type SetFieldsConfig struct {
+	Values map[string]any `json:"values"`
+}
+
+func NewSetFieldsExecutor() *SetFieldsExecutor {
+	return &SetFieldsExecutor{}
+}
+
+func (e *SetFieldsExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config SetFieldsConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	object, err := inputObject(request.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(config.Values)) {
+		if err := setPath(object, path, config.Values[path]); err != nil {
+			return nil, outbound.NewPermanentNodeExecutionError(err)
+		}
+	}
+
+	return &outbound.NodeExecutionResult{Output: object}, nil
+}
+
+// RenameFieldsExecutor moves fields of the input object from one path to another.
+type RenameFieldsExecutor struct{}
+
+type RenameFieldsConfig struct {
+	Fields map[string]string `json:"fields"`
+}
+
+func NewRenameFieldsExecutor() *RenameFieldsExecutor {
+	return &RenameFieldsExecutor{}
+}
+
+func (e *RenameFieldsExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config RenameFieldsConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	object, err := inputObject(request.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, from := range slices.Sorted(maps.Keys(config.Fields)) {
+		value, ok := getPath(object, from)
+		if !ok {
+			continue
+		}
+		deletePath(object, from)
+		if err := setPath(object, config.Fields[from], value); err != nil {
+			return nil, outbound.NewPermanentNodeExecutionError(err)
+		}
+	}
+
+	return &outbound.NodeExecutionResult{Output: object}, nil
+}
+
+// RemoveFieldsExecutor deletes fields from the input object.
+type RemoveFieldsExecutor struct{}
+
+type RemoveFieldsConfig struct {
+	Fields []string `json:"fields"`
+}
+
+func NewRemoveFieldsExecutor() *RemoveFieldsExecutor {
+	return &RemoveFieldsExecutor{}
+}
+
+func (e *RemoveFieldsExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config RemoveFieldsConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	object, err := inputObject(request.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range config.Fields {
+		deletePath(object, path)
+	}
+
+	return &outbound.NodeExecutionResult{Output: object}, nil
+}
+
+// FilterArrayExecutor keeps the items for which Condition is truthy. The
+// condition is evaluated with "item", "index" and "input" in scope.
+type FilterArrayExecutor struct {
+	evaluator outbound.ExpressionEvaluator
+}
+
+type FilterArrayConfig struct {
+	Path      string `json:"path"`
+	Condition string `json:"condition"`
+}
+
+func NewFilterArrayExecutor(evaluator outbound.ExpressionEvaluator) *FilterArrayExecutor {
+	return &FilterArrayExecutor{evaluator: evaluator}
+}
+
+func (e *FilterArrayExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config FilterArrayConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	items, err := inputArray(request.Input, config.Path)
+	if err != nil {
+		return nil, err
+	}
+	bound, err := bindInput(e.evaluator, request.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]any, 0, len(items))
+	for i, item := range items {
+		keep, err := bound.Evaluate(config.Condition, itemScope(item, i))
+		if err != nil {
+			return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("failed to evaluate condition for item %d: %w", i, err))
+		}
+		if truthy(keep) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return &outbound.NodeExecutionResult{Output: filtered}, nil
+}
+
+// MapArrayExecutor replaces every item with the result of Expression. The
+// expression is evaluated with "item", "index" and "input" in scope.
+type MapArrayExecutor struct {
+	evaluator outbound.ExpressionEvaluator
+}
+
+type MapArrayConfig struct {
+	Path       string `json:"path"`
+	Expression string `json:"expression"`
+}
+
+func NewMapArrayExecutor(evaluator outbound.ExpressionEvaluator) *MapArrayExecutor {
+	return &MapArrayExecutor{evaluator: evaluator}
+}
+
+func (e *MapArrayExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config MapArrayConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	items, err := inputArray(request.Input, config.Path)
+	if err != nil {
+		return nil, err
+	}
+	bound, err := bindInput(e.evaluator, request.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapped := make([]any, len(items))
+	for i, item := range items {
+		value, err := bound.Evaluate(config.Expression, itemScope(item, i))
+		if err != nil {
+			return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("failed to evaluate expression for item %d: %w", i, err))
+		}
+		mapped[i] = value
+	}
+
+	return &outbound.NodeExecutionResult{Output: mapped}, nil
+}
+
+// MergeObjectsExecutor merges the objects at Paths, or the objects of the
+// input array when Paths is empty. Later objects win; with Deep, nested
+// objects are merged instead of replaced.
+type MergeObjectsExecutor struct{}
+
+type MergeObjectsConfig struct {
+	Paths []string `json:"paths"`
+	Deep  bool     `json:"deep"`
+}
+
+func NewMergeObjectsExecutor() *MergeObjectsExecutor {
+	return &MergeObjectsExecutor{}
+}
+
+func (e *MergeObjectsExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config MergeObjectsConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	input, err := cloneJSON(request.Input)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+
+	var sources []any
+	if len(config.Paths) == 0 {
+		items, ok := input.([]any)
+		if !ok {
+			return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("input must be an array of objects when no paths are configured"))
+		}
+		sources = items
+	} else {
+		for _, path := range config.Paths {
+			value, ok := getPath(input, path)
+			if !ok {
+				return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("path %q not found in input", path))
+			}
+			sources = append(sources, value)
+		}
+	}
+
+	merged := make(map[string]any)
+	for i, source := range sources {
+		object, ok := source.(map[string]any)
+		if !ok {
+			return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("merge source %d is not an object", i))
+		}
+		mergeObjects(merged, object, config.Deep)
+	}
+
+	return &outbound.NodeExecutionResult{Output: merged}, nil
+}
+
+// SortArrayExecutor sorts items by the value at By. Numbers sort numerically,
+// strings lexically; mixed types sort null < bool < number < string < other.
+type SortArrayExecutor struct{}
+
+type SortArrayConfig struct {
+	Path  string `json:"path"`
+	By    string `json:"by"`
+	Order string `json:"order"`
+}
+
+func NewSortArrayExecutor() *SortArrayExecutor {
+	return &SortArrayExecutor{}
+}
+
+func (e *SortArrayExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config SortArrayConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+	if config.Order != "" && config.Order != "asc" && config.Order != "desc" {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("unsupported sort order %q", config.Order))
+	}
+
+	items, err := inputArray(request.Input, config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(items, func(a, b any) int {
+		valueA, _ := getPath(a, config.By)
+		valueB, _ := getPath(b, config.By)
+		if config.Order == "desc" {
+			return compareJSON(valueB, valueA)
+		}
+		return compareJSON(valueA, valueB)
+	})
+
+	return &outbound.NodeExecutionResult{Output: items}, nil
+}
+
+// DeduplicateArrayExecutor keeps the first item for every distinct value at
+// By, or for every distinct item when By is empty.
+type DeduplicateArrayExecutor struct{}
+
+type DeduplicateArrayConfig struct {
+	Path string `json:"path"`
+	By   string `json:"by"`
+}
+
+func NewDeduplicateArrayExecutor() *DeduplicateArrayExecutor {
+	return &DeduplicateArrayExecutor{}
+}
+
+func (e *DeduplicateArrayExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config DeduplicateArrayConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	items, err := inputArray(request.Input, config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(items))
+	unique := make([]any, 0, len(items))
+	for _, item := range items {
+		value, _ := getPath(item, config.By)
+		key := jsonKey(value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, item)
+	}
+
+	return &outbound.NodeExecutionResult{Output: unique}, nil
+}
+
+// AggregateArrayExecutor reduces items with Operation:
+//   - "count": number of items
+//   - "sum": sum of the numbers at Field
+//   - "group": the items themselves
+//
+// With GroupBy the result is an object keyed by the value at GroupBy,
+// otherwise a single value.
+type AggregateArrayExecutor struct{}
+
+type AggregateArrayConfig struct {
+	Path      string `json:"path"`
+	Operation string `json:"operation"`
+	Field     string `json:"field"`
+	GroupBy   string `json:"groupBy"`
+}
+
+func NewAggregateArrayExecutor() *AggregateArrayExecutor {
+	return &AggregateArrayExecutor{}
+}
+
+func (e *AggregateArrayExecutor) Execute(ctx context.Context, request *outbound.NodeExecutionRequest) (*outbound.NodeExecutionResult, error) {
+	var config AggregateArrayConfig
+	if err := decodeNodeConfig(request.Config, &config); err != nil {
+		return nil, err
+	}
+
+	items, err := inputArray(request.Input, config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.GroupBy == "" {
+		value, err := aggregateItems(config, items)
+		if err != nil {
+			return nil, err
+		}
+		return &outbound.NodeExecutionResult{Output: value}, nil
+	}
+
+	groups := make(map[string][]any)
+	var order []string
+	for _, item := range items {
+		value, _ := getPath(item, config.GroupBy)
+		key := groupKey(value)
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], item)
+	}
+
+	result := make(map[string]any, len(groups))
+	for _, key := range order {
+		value, err := aggregateItems(config, groups[key])
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+
+	return &outbound.NodeExecutionResult{Output: result}, nil
+}
+
+func aggregateItems(config AggregateArrayConfig, items []any) (any, error) {
+	switch config.Operation {
+	case "count":
+		return float64(len(items)), nil
+	case "sum":
+		var sum float64
+		for i, item := range items {
+			value, ok := getPath(item, config.Field)
+			if !ok || value == nil {
+				continue
+			}
+			number, ok := value.(float64)
+			if !ok {
+				return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("item %d: %q is not a number", i, config.Field))
+			}
+			sum += number
+		}
+		return sum, nil
+	case "group":
+		return items, nil
+	default:
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("unsupported aggregate operation %q", config.Operation))
+	}
+}
+
+func decodeNodeConfig(raw json.RawMessage, config any) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return outbound.NewPermanentNodeExecutionError(fmt.Errorf("invalid node config: %w", err))
+	}
+	return nil
+}
+
+func inputObject(input any) (map[string]any, error) {
+	cloned, err := cloneJSON(input)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+	if cloned == nil {
+		return make(map[string]any), nil
+	}
+	object, ok := cloned.(map[string]any)
+	if !ok {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("input must be an object"))
+	}
+	return object, nil
+}
+
+func inputArray(input any, path string) ([]any, error) {
+	cloned, err := cloneJSON(input)
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(err)
+	}
+	value, ok := getPath(cloned, path)
+	if !ok {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("path %q not found in input", path))
+	}
+	items, ok := value.([]any)
+	if !ok {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("%q is not an array", path))
+	}
+	return items, nil
+}
+
+// bindInput binds the node input as "input" once, so it is not converted
+// again for every item.
+func bindInput(evaluator outbound.ExpressionEvaluator, input any) (outbound.BoundExpressionEvaluator, error) {
+	bound, err := evaluator.Bind(map[string]any{"input": input})
+	if err != nil {
+		return nil, outbound.NewPermanentNodeExecutionError(fmt.Errorf("invalid input: %w", err))
+	}
+	return bound, nil
+}
+
+func itemScope(item any, index int) map[string]any {
+	return map[string]any{
+		"item":  item,
+		"index": index,
+	}
+}
+
+func mergeObjects(dst, src map[string]any, deep bool) {
+	for key, value := range src {
+		if deep {
+			dstObject, dstOK := dst[key].(map[string]any)
+			srcObject, srcOK := value.(map[string]any)
+			if dstOK && srcOK {
+				mergeObjects(dstObject, srcObject, true)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+func truthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case int:
+		return v != 0
+	case string:
+		return v != ""
+	default:
+		return true
+	}
+}
+
+func jsonTypeRank(value any) int {
+	switch value.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case float64:
+		return 2
+	case string:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func compareJSON(a, b any) int {
+	if rankA, rankB := jsonTypeRank(a), jsonTypeRank(b); rankA != rankB {
+		return rankA - rankB
+	}
+
+	switch a := a.(type) {
+	case bool:
+		b := b.(bool)
+		if a == b {
+			return 0
+		}
+		if !a {
+			return -1
+		}
+		return 1
+	case float64:
+		b := b.(float64)
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(a, b.(string))
+	default:
+		return strings.Compare(jsonKey(a), jsonKey(b))
+	}
+}
+
+// jsonKey returns a canonical encoding of value; encoding/json sorts object
+// keys, so equal values always produce equal keys.
+func jsonKey(value any) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+func groupKey(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return jsonKey(value)
+}
@@ -0,0 +1,294 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"testing"
+
+	"use-open-workflow.io/engine/internal/port/node/outbound"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+// pathEvaluator is a stand-in for the engine's expression evaluator: an
+// expression is a dot path into the scope, optionally prefixed with "!".
+type pathEvaluator struct{}
+
+func (pathEvaluator) Evaluate(expression string, scope map[string]any) (any, error) {
+	if len(expression) > 0 && expression[0] == '!' {
+		value, _ := getPath(scope, expression[1:])
+		return !truthy(value), nil
+	}
+	value, _ := getPath(scope, expression)
+	return value, nil
+}
+
+func (e pathEvaluator) Bind(scope map[string]any) (outbound.BoundExpressionEvaluator, error) {
+	return boundPathEvaluator{bound: scope}, nil
+}
+
+type boundPathEvaluator struct {
+	bound map[string]any
+}
+
+func (b boundPathEvaluator) Evaluate(expression string, scope map[string]any) (any, error) {
+	merged := maps.Clone(b.bound)
+	maps.Copy(merged, scope)
+	return pathEvaluator{}.Evaluate(expression, merged)
+}
+
+func decodeJSON(t *testing.T, s string) any {
+	t.Helper()
+	var value any
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		t.Fatalf("Invalid JSON %s: %v", s, err)
+	}
+	return value
+}
+
+func TestTransformExecutors(t *testing.T) {
+	executors := NewBuiltinNodeExecutors(pathEvaluator{}, id.NewULIDFactory())
+
+	tests := []struct {
+		name     string
+		nodeType string
+		config   string
+		input    string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "set creates nested fields",
+			nodeType: NodeTypeSetFields,
+			config:   `{"values":{"status":"active","meta.source":"crm"}}`,
+			input:    `{"id":1}`,
+			want:     `{"id":1,"status":"active","meta":{"source":"crm"}}`,
+		},
+		{
+			name:     "set fails on non-object parent",
+			nodeType: NodeTypeSetFields,
+			config:   `{"values":{"id.value":1}}`,
+			input:    `{"id":1}`,
+			wantErr:  true,
+		},
+		{
+			name:     "rename moves fields and skips missing ones",
+			nodeType: NodeTypeRenameFields,
+			config:   `{"fields":{"first_name":"name.first","missing":"other"}}`,
+			input:    `{"first_name":"Ada","id":1}`,
+			want:     `{"id":1,"name":{"first":"Ada"}}`,
+		},
+		{
+			name:     "remove deletes nested fields",
+			nodeType: NodeTypeRemoveFields,
+			config:   `{"fields":["secret","user.password","missing.path"]}`,
+			input:    `{"secret":"x","user":{"name":"Ada","password":"y"}}`,
+			want:     `{"user":{"name":"Ada"}}`,
+		},
+		{
+			name:     "remove requires object input",
+			nodeType: NodeTypeRemoveFields,
+			config:   `{"fields":["a"]}`,
+			input:    `[1,2]`,
+			wantErr:  true,
+		},
+		{
+			name:     "filter keeps truthy items",
+			nodeType: NodeTypeFilterArray,
+			config:   `{"path":"items","condition":"item.active"}`,
+			input:    `{"items":[{"id":1,"active":true},{"id":2,"active":false},{"id":3}]}`,
+			want:     `[{"id":1,"active":true}]`,
+		},
+		{
+			name:     "filter on root array",
+			nodeType: NodeTypeFilterArray,
+			config:   `{"condition":"!item.deleted"}`,
+			input:    `[{"id":1,"deleted":true},{"id":2}]`,
+			want:     `[{"id":2}]`,
+		},
+		{
+			name:     "filter requires an array",
+			nodeType: NodeTypeFilterArray,
+			config:   `{"path":"items","condition":"item"}`,
+			input:    `{"items":{}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "map through expression",
+			nodeType: NodeTypeMapArray,
+			config:   `{"path":"contacts","expression":"item.email"}`,
+			input:    `{"contacts":[{"email":"a@example.com"},{"email":"b@example.com"}]}`,
+			want:     `["a@example.com","b@example.com"]`,
+		},
+		{
+			name:     "merge paths shallow",
+			nodeType: NodeTypeMergeObjects,
+			config:   `{"paths":["defaults","overrides"]}`,
+			input:    `{"defaults":{"a":1,"nested":{"x":1,"y":1}},"overrides":{"b":2,"nested":{"y":2}}}`,
+			want:     `{"a":1,"b":2,"nested":{"y":2}}`,
+		},
+		{
+			name:     "merge array deep",
+			nodeType: NodeTypeMergeObjects,
+			config:   `{"deep":true}`,
+			input:    `[{"a":1,"nested":{"x":1,"y":1}},{"nested":{"y":2}}]`,
+			want:     `{"a":1,"nested":{"x":1,"y":2}}`,
+		},
+		{
+			name:     "merge rejects non-objects",
+			nodeType: NodeTypeMergeObjects,
+			config:   `{}`,
+			input:    `[{"a":1},2]`,
+			wantErr:  true,
+		},
+		{
+			name:     "sort numbers ascending",
+			nodeType: NodeTypeSortArray,
+			config:   `{"by":"price"}`,
+			input:    `[{"price":3},{"price":1},{"price":2}]`,
+			want:     `[{"price":1},{"price":2},{"price":3}]`,
+		},
+		{
+			name:     "sort strings descending with missing values last",
+			nodeType: NodeTypeSortArray,
+			config:   `{"path":"users","by":"name","order":"desc"}`,
+			input:    `{"users":[{"name":"b"},{},{"name":"c"},{"name":"a"}]}`,
+			want:     `[{"name":"c"},{"name":"b"},{"name":"a"},{}]`,
+		},
+		{
+			name:     "sort rejects unknown order",
+			nodeType: NodeTypeSortArray,
+			config:   `{"order":"random"}`,
+			input:    `[]`,
+			wantErr:  true,
+		},
+		{
+			name:     "deduplicate by field keeps first",
+			nodeType: NodeTypeDeduplicateArray,
+			config:   `{"by":"email"}`,
+			input:    `[{"email":"a","n":1},{"email":"b","n":2},{"email":"a","n":3}]`,
+			want:     `[{"email":"a","n":1},{"email":"b","n":2}]`,
+		},
+		{
+			name:     "deduplicate whole items",
+			nodeType: NodeTypeDeduplicateArray,
+			config:   `{}`,
+			input:    `[{"a":1,"b":2},{"b":2,"a":1},{"a":2}]`,
+			want:     `[{"a":1,"b":2},{"a":2}]`,
+		},
+		{
+			name:     "aggregate count",
+			nodeType: NodeTypeAggregateArray,
+			config:   `{"operation":"count"}`,
+			input:    `[1,2,3]`,
+			want:     `3`,
+		},
+		{
+			name:     "aggregate sum",
+			nodeType: NodeTypeAggregateArray,
+			config:   `{"operation":"sum","field":"amount"}`,
+			input:    `[{"amount":1.5},{"amount":2},{}]`,
+			want:     `3.5`,
+		},
+		{
+			name:     "aggregate sum rejects non-numbers",
+			nodeType: NodeTypeAggregateArray,
+			config:   `{"operation":"sum","field":"amount"}`,
+			input:    `[{"amount":"1"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "aggregate sum grouped",
+			nodeType: NodeTypeAggregateArray,
+			config:   `{"operation":"sum","field":"amount","groupBy":"currency"}`,
+			input:    `[{"currency":"EUR","amount":1},{"currency":"USD","amount":2},{"currency":"EUR","amount":3}]`,
+			want:     `{"EUR":4,"USD":2}`,
+		},
+		{
+			name:     "aggregate group collects items",
+			nodeType: NodeTypeAggregateArray,
+			config:   `{"operation":"group","groupBy":"team"}`,
+			input:    `[{"team":"a","id":1},{"team":"b","id":2},{"team":"a","id":3}]`,
+			want:     `{"a":[{"team":"a","id":1},{"team":"a","id":3}],"b":[{"team":"b","id":2}]}`,
+		},
+		{
+			name:     "aggregate rejects unknown operation",
+			nodeType: NodeTypeAggregateArray,
+			config:   `{"operation":"median"}`,
+			input:    `[]`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := executors[tt.nodeType].Execute(context.Background(), &outbound.NodeExecutionRequest{
+				Config: json.RawMessage(tt.config),
+				Input:  decodeJSON(t, tt.input),
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Expected error, got output %v", result.Output)
+				}
+				if outbound.IsRetryableNodeExecutionError(err) {
+					t.Errorf("Expected transform error to be permanent, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			got := decodeJSON(t, jsonKey(result.Output))
+			if want := decodeJSON(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("Expected %s, got %s", tt.want, jsonKey(result.Output))
+			}
+		})
+	}
+}
+
+func TestTransformExecutors_DoNotMutateInput(t *testing.T) {
+	input := decodeJSON(t, `{"a":1,"items":[{"n":2},{"n":1}]}`)
+
+	executors := NewBuiltinNodeExecutors(pathEvaluator{}, id.NewULIDFactory())
+	requests := map[string]string{
+		NodeTypeSetFields:    `{"values":{"a":2}}`,
+		NodeTypeRemoveFields: `{"fields":["a"]}`,
+		NodeTypeSortArray:    `{"path":"items","by":"n"}`,
+	}
+	for nodeType, config := range requests {
+		if _, err := executors[nodeType].Execute(context.Background(), &outbound.NodeExecutionRequest{
+			Config: json.RawMessage(config),
+			Input:  input,
+		}); err != nil {
+			t.Fatalf("%s: expected no error, got %v", nodeType, err)
+		}
+	}
+
+	if want := decodeJSON(t, `{"a":1,"items":[{"n":2},{"n":1}]}`); !reflect.DeepEqual(input, want) {
+		t.Errorf("Input was mutated: %v", input)
+	}
+}
+
+// BenchmarkFilterArrayExecutor_LargeInput filters every item of an input
+// that also carries the items, so converting the input per item would make
+// it quadratic.
+func BenchmarkFilterArrayExecutor_LargeInput(b *testing.B) {
+	items := make([]any, 2000)
+	for i := range items {
+		items[i] = map[string]any{"id": float64(i), "active": i%2 == 0}
+	}
+	executor := NewFilterArrayExecutor(NewStarlarkExpressionEvaluator())
+	request := &outbound.NodeExecutionRequest{
+		Config: json.RawMessage(`{"path":"items","condition":"item[\"active\"]"}`),
+		Input:  map[string]any{"items": items},
+	}
+
+	for b.Loop() {
+		if _, err := executor.Execute(context.Background(), request); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
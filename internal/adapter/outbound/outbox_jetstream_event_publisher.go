@@ -0,0 +1,72 @@
+package outbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"use-open-workflow.io/engine/internal/port/outbound"
+)
+
+const (
+	JetStreamAggregateIDHeader   = "Aggregate-Id"
+	JetStreamAggregateTypeHeader = "Aggregate-Type"
+	JetStreamEventTypeHeader     = "Event-Type"
+)
+
+// OutboxJetStreamEventPublisher publishes each message to
+// "<prefix>.<AggregateType>.<EventType>". The outbox ID is sent as
+// Nats-Msg-Id, so JetStream drops redeliveries within the stream's
+// duplicate window.
+type OutboxJetStreamEventPublisher struct {
+	js            jetstream.JetStream
+	subjectPrefix string
+}
+
+func NewOutboxJetStreamEventPublisher(js jetstream.JetStream, subjectPrefix string) *OutboxJetStreamEventPublisher {
+	return &OutboxJetStreamEventPublisher{
+		js:            js,
+		subjectPrefix: subjectPrefix,
+	}
+}
+
+func (p *OutboxJetStreamEventPublisher) Publish(ctx context.Context, msg *outbound.OutboxMessage) error {
+	natsMsg := nats.NewMsg(p.Subject(msg))
+	natsMsg.Data = msg.Payload
+	natsMsg.Header.Set(JetStreamAggregateIDHeader, msg.AggregateID)
+	natsMsg.Header.Set(JetStreamAggregateTypeHeader, msg.AggregateType)
+	natsMsg.Header.Set(JetStreamEventTypeHeader, msg.EventType)
+
+	if _, err := p.js.PublishMsg(ctx, natsMsg, jetstream.WithMsgID(msg.ID)); err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", natsMsg.Subject, err)
+	}
+	return nil
+}
+
+// Subject returns the subject msg is published to.
+func (p *OutboxJetStreamEventPublisher) Subject(msg *outbound.OutboxMessage) string {
+	return p.subjectPrefix + "." + msg.AggregateType + "." + msg.EventType
+}
+
+// EnsureJetStreamStream creates a stream capturing "<subjectPrefix>.>" unless
+// a stream with that name already exists. Existing streams are left as they
+// are, so operators can manage retention and replicas themselves.
+func EnsureJetStreamStream(ctx context.Context, js jetstream.JetStream, name, subjectPrefix string) error {
+	_, err := js.Stream(ctx, name)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, jetstream.ErrStreamNotFound) {
+		return fmt.Errorf("failed to look up stream %s: %w", name, err)
+	}
+
+	if _, err := js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:     name,
+		Subjects: []string{subjectPrefix + ".>"},
+	}); err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", name, err)
+	}
+	return nil
+}
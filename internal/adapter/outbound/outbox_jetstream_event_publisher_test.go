@@ -0,0 +1,103 @@
+package outbound
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func startJetStream(t *testing.T) jetstream.JetStream {
+	t.Helper()
+
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create NATS server: %v", err)
+	}
+	srv.Start()
+	t.Cleanup(srv.Shutdown)
+	if !srv.ReadyForConnections(5 * time.Second) {
+		t.Fatal("NATS server not ready")
+	}
+
+	nc, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatalf("Failed to connect to NATS: %v", err)
+	}
+	t.Cleanup(nc.Close)
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatalf("Failed to create JetStream context: %v", err)
+	}
+	return js
+}
+
+func TestOutboxJetStreamEventPublisher_PublishesWithMsgID(t *testing.T) {
+	ctx := context.Background()
+	js := startJetStream(t)
+	if err := EnsureJetStreamStream(ctx, js, "OPENWORKFLOW", "openworkflow"); err != nil {
+		t.Fatalf("Failed to ensure stream: %v", err)
+	}
+	// A second call must accept the existing stream.
+	if err := EnsureJetStreamStream(ctx, js, "OPENWORKFLOW", "openworkflow"); err != nil {
+		t.Fatalf("Expected existing stream to be accepted, got %v", err)
+	}
+
+	publisher := NewOutboxJetStreamEventPublisher(js, "openworkflow")
+	msg := testOutboxMessage()
+
+	// Publishing twice simulates a retry after a lost MarkProcessed.
+	for range 2 {
+		if err := publisher.Publish(ctx, msg); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	stream, err := js.Stream(ctx, "OPENWORKFLOW")
+	if err != nil {
+		t.Fatalf("Failed to get stream: %v", err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get stream info: %v", err)
+	}
+	if info.State.Msgs != 1 {
+		t.Fatalf("Expected duplicate to be dropped, stream has %d messages", info.State.Msgs)
+	}
+
+	stored, err := stream.GetLastMsgForSubject(ctx, "openworkflow.NodeTemplate.CreateNodeTemplate")
+	if err != nil {
+		t.Fatalf("Expected message on openworkflow.NodeTemplate.CreateNodeTemplate: %v", err)
+	}
+	if got := stored.Header.Get(jetstream.MsgIDHeader); got != msg.ID {
+		t.Errorf("Expected Nats-Msg-Id %s, got %s", msg.ID, got)
+	}
+	if got := stored.Header.Get(JetStreamAggregateIDHeader); got != msg.AggregateID {
+		t.Errorf("Expected aggregate ID header %s, got %s", msg.AggregateID, got)
+	}
+	if string(stored.Data) != string(msg.Payload) {
+		t.Errorf("Expected payload %s, got %s", msg.Payload, stored.Data)
+	}
+}
+
+func TestOutboxJetStreamEventPublisher_NoStreamIsError(t *testing.T) {
+	js := startJetStream(t)
+	publisher := NewOutboxJetStreamEventPublisher(js, "unbound")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := publisher.Publish(ctx, testOutboxMessage()); err == nil {
+		t.Fatal("Expected error when no stream captures the subject")
+	}
+}
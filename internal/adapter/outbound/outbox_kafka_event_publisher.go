@@ -0,0 +1,61 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"use-open-workflow.io/engine/internal/port/outbound"
+)
+
+const (
+	KafkaOutboxIDHeader      = "outbox_id"
+	KafkaAggregateIDHeader   = "aggregate_id"
+	KafkaAggregateTypeHeader = "aggregate_type"
+	KafkaEventTypeHeader     = "event_type"
+)
+
+// OutboxKafkaEventPublisher produces each message keyed by AggregateID. The
+// client's default partitioner hashes the key, so all events of an aggregate
+// land on one partition and keep their order there. Messages go to the topic
+// routed for their AggregateType, or to the default topic.
+type OutboxKafkaEventPublisher struct {
+	client       *kgo.Client
+	defaultTopic string
+	topics       map[string]string
+}
+
+func NewOutboxKafkaEventPublisher(client *kgo.Client, defaultTopic string, topics map[string]string) *OutboxKafkaEventPublisher {
+	return &OutboxKafkaEventPublisher{
+		client:       client,
+		defaultTopic: defaultTopic,
+		topics:       topics,
+	}
+}
+
+func (p *OutboxKafkaEventPublisher) Publish(ctx context.Context, msg *outbound.OutboxMessage) error {
+	record := &kgo.Record{
+		Topic: p.Topic(msg.AggregateType),
+		Key:   []byte(msg.AggregateID),
+		Value: msg.Payload,
+		Headers: []kgo.RecordHeader{
+			{Key: KafkaOutboxIDHeader, Value: []byte(msg.ID)},
+			{Key: KafkaAggregateIDHeader, Value: []byte(msg.AggregateID)},
+			{Key: KafkaAggregateTypeHeader, Value: []byte(msg.AggregateType)},
+			{Key: KafkaEventTypeHeader, Value: []byte(msg.EventType)},
+		},
+	}
+
+	if err := p.client.ProduceSync(ctx, record).FirstErr(); err != nil {
+		return fmt.Errorf("failed to produce to %s: %w", record.Topic, err)
+	}
+	return nil
+}
+
+// Topic returns the topic events of aggregateType are produced to.
+func (p *OutboxKafkaEventPublisher) Topic(aggregateType string) string {
+	if topic, ok := p.topics[aggregateType]; ok {
+		return topic
+	}
+	return p.defaultTopic
+}
@@ -0,0 +1,141 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"use-open-workflow.io/engine/internal/port/outbound"
+)
+
+func startKafka(t *testing.T, topics ...string) []string {
+	t.Helper()
+
+	cluster, err := kfake.NewCluster(
+		kfake.NumBrokers(1),
+		kfake.SeedTopics(3, topics...),
+	)
+	if err != nil {
+		t.Fatalf("Failed to start fake Kafka cluster: %v", err)
+	}
+	t.Cleanup(cluster.Close)
+	return cluster.ListenAddrs()
+}
+
+func newKafkaClient(t *testing.T, brokers []string, opts ...kgo.Opt) *kgo.Client {
+	t.Helper()
+
+	client, err := kgo.NewClient(append([]kgo.Opt{kgo.SeedBrokers(brokers...)}, opts...)...)
+	if err != nil {
+		t.Fatalf("Failed to create Kafka client: %v", err)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+func consumeKafka(t *testing.T, brokers []string, topic string, n int) []*kgo.Record {
+	t.Helper()
+
+	consumer := newKafkaClient(t, brokers, kgo.ConsumeTopics(topic), kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var records []*kgo.Record
+	for len(records) < n {
+		fetches := consumer.PollFetches(ctx)
+		if ctx.Err() != nil {
+			t.Fatalf("Timed out after consuming %d of %d records from %s", len(records), n, topic)
+		}
+		records = append(records, fetches.Records()...)
+	}
+	return records
+}
+
+func kafkaHeader(record *kgo.Record, key string) string {
+	for _, h := range record.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func TestOutboxKafkaEventPublisher_KeepsAggregateOrderWithinPartition(t *testing.T) {
+	brokers := startKafka(t, "openworkflow.events")
+	publisher := NewOutboxKafkaEventPublisher(newKafkaClient(t, brokers), "openworkflow.events", nil)
+
+	aggregates := []string{"agg-a", "agg-b", "agg-c", "agg-d"}
+	const eventsPerAggregate = 5
+	for i := range eventsPerAggregate {
+		for _, aggregateID := range aggregates {
+			msg := &outbound.OutboxMessage{
+				ID:            fmt.Sprintf("%s-%d", aggregateID, i),
+				AggregateID:   aggregateID,
+				AggregateType: "NodeTemplate",
+				EventType:     "UpdateNodeTemplate",
+				Payload:       []byte(fmt.Sprintf(`{"seq":%d}`, i)),
+			}
+			if err := publisher.Publish(context.Background(), msg); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+	}
+
+	records := consumeKafka(t, brokers, "openworkflow.events", len(aggregates)*eventsPerAggregate)
+
+	partitions := map[string]int32{}
+	next := map[string]int{}
+	for _, record := range records {
+		aggregateID := string(record.Key)
+		if partition, ok := partitions[aggregateID]; ok && partition != record.Partition {
+			t.Errorf("Aggregate %s spread over partitions %d and %d", aggregateID, partition, record.Partition)
+		}
+		partitions[aggregateID] = record.Partition
+
+		if want := fmt.Sprintf("%s-%d", aggregateID, next[aggregateID]); kafkaHeader(record, KafkaOutboxIDHeader) != want {
+			t.Errorf("Expected %s next for %s, got %s", want, aggregateID, kafkaHeader(record, KafkaOutboxIDHeader))
+		}
+		next[aggregateID]++
+	}
+}
+
+func TestOutboxKafkaEventPublisher_RoutesTopicAndSetsHeaders(t *testing.T) {
+	brokers := startKafka(t, "openworkflow.events", "node-template.events")
+	publisher := NewOutboxKafkaEventPublisher(newKafkaClient(t, brokers), "openworkflow.events", map[string]string{
+		"NodeTemplate": "node-template.events",
+	})
+
+	msg := testOutboxMessage()
+	if err := publisher.Publish(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	other := &outbound.OutboxMessage{ID: "other", AggregateID: "sub", AggregateType: "Subscription", EventType: "CreateSubscription", Payload: []byte(`{}`)}
+	if err := publisher.Publish(context.Background(), other); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	record := consumeKafka(t, brokers, "node-template.events", 1)[0]
+	if string(record.Key) != msg.AggregateID {
+		t.Errorf("Expected key %s, got %s", msg.AggregateID, record.Key)
+	}
+	if string(record.Value) != string(msg.Payload) {
+		t.Errorf("Expected value %s, got %s", msg.Payload, record.Value)
+	}
+	for key, want := range map[string]string{
+		KafkaOutboxIDHeader:      msg.ID,
+		KafkaAggregateIDHeader:   msg.AggregateID,
+		KafkaAggregateTypeHeader: "NodeTemplate",
+		KafkaEventTypeHeader:     "CreateNodeTemplate",
+	} {
+		if got := kafkaHeader(record, key); got != want {
+			t.Errorf("Expected header %s=%s, got %s", key, want, got)
+		}
+	}
+
+	if record := consumeKafka(t, brokers, "openworkflow.events", 1)[0]; kafkaHeader(record, KafkaOutboxIDHeader) != "other" {
+		t.Errorf("Expected unrouted aggregate type on default topic, got %s", kafkaHeader(record, KafkaOutboxIDHeader))
+	}
+}
@@ -0,0 +1,54 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OutboxNotifyChannel is the Postgres channel notified when outbox messages
+// are written.
+const OutboxNotifyChannel = "outbox_message"
+
+// OutboxPostgresNotificationListener LISTENs on OutboxNotifyChannel. It takes
+// a connection out of the pool for good, since a LISTEN has to stay on one
+// session and cancelling a wait leaves the connection unusable for others.
+type OutboxPostgresNotificationListener struct {
+	pool *pgxpool.Pool
+}
+
+func NewOutboxPostgresNotificationListener(pool *pgxpool.Pool) *OutboxPostgresNotificationListener {
+	return &OutboxPostgresNotificationListener{pool: pool}
+}
+
+func (l *OutboxPostgresNotificationListener) Listen(ctx context.Context, wake chan<- struct{}) error {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{OutboxNotifyChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", OutboxNotifyChannel, err)
+	}
+
+	signal(wake)
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+		signal(wake)
+	}
+}
+
+// signal wakes the receiver without blocking; a pending wake-up already
+// covers this one.
+func signal(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
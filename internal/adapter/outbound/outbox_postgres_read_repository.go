@@ -2,12 +2,16 @@ package outbound
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"use-open-workflow.io/engine/internal/port/outbound"
 )
 
+const outboxColumns = `id, aggregate_id, aggregate_type, event_type, payload, created_at, processed_at, retry_count, next_attempt_at, last_error, dead_lettered_at`
+
 type OutboxPostgresReadRepository struct {
 	pool *pgxpool.Pool
 }
@@ -16,15 +20,39 @@ func NewOutboxPostgresReadRepository(pool *pgxpool.Pool) *OutboxPostgresReadRepo
 	return &OutboxPostgresReadRepository{pool: pool}
 }
 
-func (r *OutboxPostgresReadRepository) FindUnprocessed(ctx context.Context, limit int) ([]*outbound.OutboxMessage, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, retry_count
+func (r *OutboxPostgresReadRepository) FindDeadLettered(ctx context.Context, limit, offset int) ([]*outbound.OutboxMessage, error) {
+	return queryOutboxMessages(ctx, r.pool, `
+		SELECT `+outboxColumns+`
+		FROM outbox
+		WHERE dead_lettered_at IS NOT NULL
+		ORDER BY dead_lettered_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+	`, limit, offset)
+}
+
+func (r *OutboxPostgresReadRepository) FindByID(ctx context.Context, id string) (*outbound.OutboxMessage, error) {
+	msg, err := scanOutboxMessage(r.pool.QueryRow(ctx, `
+		SELECT `+outboxColumns+`
 		FROM outbox
-		WHERE processed_at IS NULL AND retry_count < 5
-		ORDER BY created_at ASC
-		LIMIT $1
-		FOR UPDATE SKIP LOCKED
-	`, limit)
+		WHERE id = $1
+	`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outbox message: %w", err)
+	}
+	return msg, nil
+}
+
+// queryer is satisfied by both pgx.Tx and *pgxpool.Pool.
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// queryOutboxMessages runs a query selecting or returning outboxColumns.
+func queryOutboxMessages(ctx context.Context, q queryer, sql string, args ...any) ([]*outbound.OutboxMessage, error) {
+	rows, err := q.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query outbox: %w", err)
 	}
@@ -32,16 +60,8 @@ func (r *OutboxPostgresReadRepository) FindUnprocessed(ctx context.Context, limi
 
 	var messages []*outbound.OutboxMessage
 	for rows.Next() {
-		msg := &outbound.OutboxMessage{}
-		if err := rows.Scan(
-			&msg.ID,
-			&msg.AggregateID,
-			&msg.AggregateType,
-			&msg.EventType,
-			&msg.Payload,
-			&msg.CreatedAt,
-			&msg.RetryCount,
-		); err != nil {
+		msg, err := scanOutboxMessage(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
 		}
 		messages = append(messages, msg)
@@ -53,3 +73,27 @@ func (r *OutboxPostgresReadRepository) FindUnprocessed(ctx context.Context, limi
 
 	return messages, nil
 }
+
+func scanOutboxMessage(row pgx.Row) (*outbound.OutboxMessage, error) {
+	msg := &outbound.OutboxMessage{}
+	var lastError *string
+	if err := row.Scan(
+		&msg.ID,
+		&msg.AggregateID,
+		&msg.AggregateType,
+		&msg.EventType,
+		&msg.Payload,
+		&msg.CreatedAt,
+		&msg.ProcessedAt,
+		&msg.RetryCount,
+		&msg.NextAttemptAt,
+		&lastError,
+		&msg.DeadLetteredAt,
+	); err != nil {
+		return nil, err
+	}
+	if lastError != nil {
+		msg.LastError = *lastError
+	}
+	return msg, nil
+}
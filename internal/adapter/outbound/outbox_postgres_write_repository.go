@@ -3,11 +3,20 @@ package outbound
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"use-open-workflow.io/engine/internal/port/outbound"
+	"use-open-workflow.io/engine/pkg/domain"
 )
 
+// outboxClaimLock serializes ClaimInOrder calls. Concurrent claims could
+// otherwise split one aggregate's messages between two processors, as
+// neither sees the other's uncommitted claim.
+const outboxClaimLock = 0x6f7574626f78 // "outbox"
+
 type OutboxPostgresWriteRepository struct {
 	pool *pgxpool.Pool
 }
@@ -16,30 +25,210 @@ func NewOutboxPostgresWriteRepository(pool *pgxpool.Pool) *OutboxPostgresWriteRe
 	return &OutboxPostgresWriteRepository{pool: pool}
 }
 
-func (r *OutboxPostgresWriteRepository) MarkProcessed(ctx context.Context, id string) error {
+func (r *OutboxPostgresWriteRepository) Claim(ctx context.Context, claimedBy string, lease time.Duration, limit int) ([]*outbound.OutboxMessage, error) {
+	messages, err := queryOutboxMessages(ctx, r.pool, `
+		UPDATE outbox
+		SET claimed_by = $1, claimed_until = NOW() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id
+			FROM outbox
+			WHERE processed_at IS NULL AND dead_lettered_at IS NULL AND next_attempt_at <= NOW()
+				AND (claimed_until IS NULL OR claimed_until <= NOW())
+			ORDER BY created_at ASC, id ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+outboxColumns, claimedBy, lease.Seconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+	sortOutboxMessages(messages)
+	return messages, nil
+}
+
+func (r *OutboxPostgresWriteRepository) ClaimInOrder(ctx context.Context, claimedBy string, lease time.Duration, limit int) ([]*outbound.OutboxMessage, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, outboxClaimLock); err != nil {
+		return nil, fmt.Errorf("failed to lock outbox claims: %w", err)
+	}
+
+	// Earlier pending messages that are due and unclaimed are claimed ahead
+	// of o, so only the ones waiting for a retry or held by another
+	// processor have to hold o back.
+	messages, err := queryOutboxMessages(ctx, tx, `
+		UPDATE outbox
+		SET claimed_by = $1, claimed_until = NOW() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT o.id
+			FROM outbox o
+			WHERE o.processed_at IS NULL AND o.dead_lettered_at IS NULL AND o.next_attempt_at <= NOW()
+				AND (o.claimed_until IS NULL OR o.claimed_until <= NOW())
+				AND NOT EXISTS (
+					SELECT 1
+					FROM outbox earlier
+					WHERE earlier.aggregate_type = o.aggregate_type
+						AND earlier.aggregate_id = o.aggregate_id
+						AND earlier.processed_at IS NULL
+						AND earlier.dead_lettered_at IS NULL
+						AND (earlier.next_attempt_at > NOW() OR earlier.claimed_until > NOW())
+						AND (earlier.created_at, earlier.id) < (o.created_at, o.id)
+				)
+			ORDER BY o.created_at ASC, o.id ASC
+			LIMIT $3
+			FOR UPDATE OF o SKIP LOCKED
+		)
+		RETURNING `+outboxColumns, claimedBy, lease.Seconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	sortOutboxMessages(messages)
+	return messages, nil
+}
+
+// sortOutboxMessages restores creation order, which RETURNING does not keep.
+func sortOutboxMessages(messages []*outbound.OutboxMessage) {
+	slices.SortFunc(messages, func(a, b *outbound.OutboxMessage) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+}
+
+func (r *OutboxPostgresWriteRepository) MarkProcessed(ctx context.Context, claimedBy string, ids []string) error {
 	_, err := r.pool.Exec(ctx, `
 		UPDATE outbox
 		SET processed_at = NOW()
-		WHERE id = $1
-	`, id)
+		WHERE id = ANY($1) AND claimed_by = $2
+	`, ids, claimedBy)
 	if err != nil {
-		return fmt.Errorf("failed to mark message as processed: %w", err)
+		return fmt.Errorf("failed to mark messages as processed: %w", err)
 	}
 	return nil
 }
 
-func (r *OutboxPostgresWriteRepository) IncrementRetry(ctx context.Context, id string) error {
+func (r *OutboxPostgresWriteRepository) IncrementRetry(ctx context.Context, claimedBy string, id string, nextAttemptAt time.Time, lastError string) error {
 	_, err := r.pool.Exec(ctx, `
 		UPDATE outbox
-		SET retry_count = retry_count + 1
-		WHERE id = $1
-	`, id)
+		SET retry_count = retry_count + 1, next_attempt_at = $2, last_error = $3,
+			claimed_by = NULL, claimed_until = NULL
+		WHERE id = $1 AND claimed_by = $4
+	`, id, nextAttemptAt, lastError, claimedBy)
 	if err != nil {
 		return fmt.Errorf("failed to increment retry count: %w", err)
 	}
 	return nil
 }
 
+func (r *OutboxPostgresWriteRepository) DeadLetter(ctx context.Context, claimedBy string, id string, lastError string, event domain.Event) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE outbox
+		SET retry_count = retry_count + 1, last_error = $2, dead_lettered_at = NOW(),
+			claimed_by = NULL, claimed_until = NULL
+		WHERE id = $1 AND claimed_by = $3
+	`, id, lastError, claimedBy)
+	if err != nil {
+		return fmt.Errorf("failed to dead-letter message: %w", err)
+	}
+
+	// Another processor owns the message now; it decides what happens next.
+	if tag.RowsAffected() > 0 && event != nil {
+		if err := insertOutboxEvent(ctx, tx, event); err != nil {
+			return err
+		}
+		if err := notifyOutbox(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxPostgresWriteRepository) ExtendClaims(ctx context.Context, claimedBy string, lease time.Duration) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE outbox
+		SET claimed_until = NOW() + make_interval(secs => $2)
+		WHERE claimed_by = $1 AND processed_at IS NULL AND dead_lettered_at IS NULL
+	`, claimedBy, lease.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to extend claims: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxPostgresWriteRepository) ReleaseClaims(ctx context.Context, claimedBy string, ids []string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE outbox
+		SET claimed_by = NULL, claimed_until = NULL
+		WHERE id = ANY($1) AND claimed_by = $2
+	`, ids, claimedBy)
+	if err != nil {
+		return fmt.Errorf("failed to release claims: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxPostgresWriteRepository) Requeue(ctx context.Context, ids []string) (int64, error) {
+	return r.requeue(ctx, `
+		UPDATE outbox
+		SET dead_lettered_at = NULL, retry_count = 0, next_attempt_at = NOW()
+		WHERE id = ANY($1) AND dead_lettered_at IS NOT NULL
+	`, ids)
+}
+
+func (r *OutboxPostgresWriteRepository) RequeueAll(ctx context.Context, eventType string) (int64, error) {
+	return r.requeue(ctx, `
+		UPDATE outbox
+		SET dead_lettered_at = NULL, retry_count = 0, next_attempt_at = NOW()
+		WHERE dead_lettered_at IS NOT NULL AND ($1 = '' OR event_type = $1)
+	`, eventType)
+}
+
+// requeue runs a requeue update and, if it matched any messages, wakes
+// listening processors in the same transaction so requeued messages go out
+// without waiting for the next poll.
+func (r *OutboxPostgresWriteRepository) requeue(ctx context.Context, sql string, args ...any) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue messages: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, nil
+	}
+	if err := notifyOutbox(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
 func (r *OutboxPostgresWriteRepository) DeleteProcessed(ctx context.Context, olderThan time.Duration) error {
 	cutoff := time.Now().Add(-olderThan)
 	_, err := r.pool.Exec(ctx, `
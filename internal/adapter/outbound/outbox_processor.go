@@ -2,18 +2,49 @@ package outbound
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"math/rand/v2"
 	"sync"
 	"time"
 
+	"use-open-workflow.io/engine/internal/domain/outbox/event"
 	"use-open-workflow.io/engine/internal/port/outbound"
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
 )
 
+// outboxRecordTimeout bounds recording the outcome of a batch once the
+// processor's context is cancelled.
+const outboxRecordTimeout = 10 * time.Second
+
 type Config struct {
-	BatchSize       int
+	BatchSize int
+	// PollInterval is how often the outbox is polled. With a notification
+	// listener it is only the fallback for missed notifications.
 	PollInterval    time.Duration
 	CleanupInterval time.Duration
 	RetentionPeriod time.Duration
+	// RetryBaseDelay is the delay before the first retry. It doubles with
+	// every failed attempt up to RetryMaxDelay, with random jitter.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// MaxAttempts is how often a message is tried before it is
+	// dead-lettered.
+	MaxAttempts int
+	// OrderedDelivery publishes the events of one aggregate strictly in
+	// order: after a failure, later events of that aggregate wait until the
+	// failed one is published or given up on. Different aggregates are
+	// published in parallel.
+	OrderedDelivery bool
+	// Parallelism is how many aggregates are published at the same time.
+	// The messages of one aggregate are always published one after another.
+	Parallelism int
+	// ClaimLease is how long other processors leave a claimed batch alone.
+	// The lease is renewed every third of ClaimLease while the batch is
+	// published, so it only runs out when a processor stops renewing it,
+	// e.g. because it crashed. Its messages are then claimed elsewhere.
+	ClaimLease time.Duration
 }
 
 func DefaultConfig() Config {
@@ -22,13 +53,22 @@ func DefaultConfig() Config {
 		PollInterval:    5 * time.Second,
 		CleanupInterval: 1 * time.Hour,
 		RetentionPeriod: 7 * 24 * time.Hour, // 7 days
+		RetryBaseDelay:  5 * time.Second,
+		RetryMaxDelay:   1 * time.Hour,
+		MaxAttempts:     5,
+		Parallelism:     8,
+		ClaimLease:      5 * time.Minute,
 	}
 }
 
+// OutboxProcessor publishes the messages it claimed under its own ID, so any
+// number of processors can run against one outbox.
 type OutboxProcessor struct {
-	readRepository  outbound.OutboxReadRepository
+	id              string
 	writeRepository outbound.OutboxWriteRepository
 	eventPublisher  outbound.OutboxEventPublisher
+	listener        outbound.OutboxNotificationListener
+	idFactory       id.Factory
 	config          Config
 
 	stopCh chan struct{}
@@ -36,15 +76,18 @@ type OutboxProcessor struct {
 }
 
 func NewOutboxProcessor(
-	readRepository outbound.OutboxReadRepository,
 	writeRepository outbound.OutboxWriteRepository,
 	publisher outbound.OutboxEventPublisher,
+	listener outbound.OutboxNotificationListener,
+	idFactory id.Factory,
 	config Config,
 ) *OutboxProcessor {
 	return &OutboxProcessor{
-		readRepository:  readRepository,
+		id:              idFactory.New(),
 		writeRepository: writeRepository,
 		eventPublisher:  publisher,
+		listener:        listener,
+		idFactory:       idFactory,
 		config:          config,
 		stopCh:          make(chan struct{}),
 	}
@@ -74,10 +117,26 @@ func (p *OutboxProcessor) Stop() error {
 	return nil
 }
 
+// processLoop processes a batch on every poll and, when a listener is set,
+// whenever it reports new messages.
 func (p *OutboxProcessor) processLoop(ctx context.Context) {
 	ticker := time.NewTicker(p.config.PollInterval)
 	defer ticker.Stop()
 
+	wake := make(chan struct{}, 1)
+	if p.listener != nil {
+		var listening sync.WaitGroup
+		listenCtx, cancel := context.WithCancel(ctx)
+		defer listening.Wait()
+		defer cancel()
+
+		listening.Add(1)
+		go func() {
+			defer listening.Done()
+			p.listenLoop(listenCtx, wake)
+		}()
+	}
+
 	for {
 		select {
 		case <-p.stopCh:
@@ -85,34 +144,234 @@ func (p *OutboxProcessor) processLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if err := p.processBatch(ctx); err != nil {
-				log.Printf("Error processing outbox batch: %v", err)
-			}
+		case <-wake:
+		}
+		if err := p.processBatch(ctx); err != nil {
+			log.Printf("Error processing outbox batch: %v", err)
+		}
+	}
+}
+
+// listenLoop keeps the listener running, reconnecting after a PollInterval
+// when it fails. Polling covers the gap.
+func (p *OutboxProcessor) listenLoop(ctx context.Context, wake chan<- struct{}) {
+	for {
+		err := p.listener.Listen(ctx, wake)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Outbox notification listener failed, retrying in %v: %v", p.config.PollInterval, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.config.PollInterval):
 		}
 	}
 }
 
 func (p *OutboxProcessor) processBatch(ctx context.Context) error {
-	messages, err := p.readRepository.FindUnprocessed(ctx, p.config.BatchSize)
+	claim := p.writeRepository.Claim
+	if p.config.OrderedDelivery {
+		claim = p.writeRepository.ClaimInOrder
+	}
+	messages, err := claim(ctx, p.id, p.config.ClaimLease, p.config.BatchSize)
 	if err != nil {
 		return err
 	}
+	if len(messages) == 0 {
+		return nil
+	}
 
-	for _, msg := range messages {
-		if err := p.eventPublisher.Publish(ctx, msg); err != nil {
-			log.Printf("Failed to publish message %s: %v", msg.ID, err)
-			if err := p.writeRepository.IncrementRetry(ctx, msg.ID); err != nil {
-				log.Printf("Failed to increment retry for %s: %v", msg.ID, err)
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	var heartbeat sync.WaitGroup
+	heartbeat.Go(func() { p.heartbeat(heartbeatCtx) })
+	defer heartbeat.Wait()
+	defer stopHeartbeat()
+
+	published := p.publishAll(ctx, messages)
+	if len(published) == 0 {
+		return nil
+	}
+	recordCtx, cancel := recordContext(ctx)
+	defer cancel()
+	if err := p.writeRepository.MarkProcessed(recordCtx, p.id, published); err != nil {
+		return fmt.Errorf("failed to mark %d messages as processed: %w", len(published), err)
+	}
+	return nil
+}
+
+// heartbeat renews the lease on the batch being published until ctx ends.
+func (p *OutboxProcessor) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(max(p.config.ClaimLease/3, time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.writeRepository.ExtendClaims(ctx, p.id, p.config.ClaimLease); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to extend outbox claims: %v", err)
 			}
-			continue
 		}
+	}
+}
 
-		if err := p.writeRepository.MarkProcessed(ctx, msg.ID); err != nil {
-			log.Printf("Failed to mark message %s as processed: %v", msg.ID, err)
-		}
+// publishAll publishes up to Config.Parallelism aggregates at a time, each
+// aggregate's messages in order, and returns the IDs of the published ones.
+// With OrderedDelivery an aggregate stops at its first failure and the claims
+// on its remaining messages are released; the failed message is still the
+// oldest pending one for its aggregate, so a later batch resumes there.
+// Once ctx is cancelled, messages not yet published are released untouched.
+func (p *OutboxProcessor) publishAll(ctx context.Context, messages []*outbound.OutboxMessage) []string {
+	groups := groupByAggregate(messages)
+	next := make(chan []*outbound.OutboxMessage)
+
+	var (
+		mu        sync.Mutex
+		published []string
+		wg        sync.WaitGroup
+	)
+	for range min(max(p.config.Parallelism, 1), len(groups)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range next {
+				for i, msg := range group {
+					if ctx.Err() != nil {
+						p.releaseClaims(ctx, group[i:])
+						break
+					}
+					if !p.processMessage(ctx, msg) {
+						if p.config.OrderedDelivery {
+							p.releaseClaims(ctx, group[i+1:])
+							break
+						}
+						continue
+					}
+					mu.Lock()
+					published = append(published, msg.ID)
+					mu.Unlock()
+				}
+			}
+		}()
 	}
 
-	return nil
+	for _, group := range groups {
+		next <- group
+	}
+	close(next)
+	wg.Wait()
+
+	return published
+}
+
+// releaseClaims lets messages that were skipped be claimed again without
+// waiting for the lease to run out.
+func (p *OutboxProcessor) releaseClaims(ctx context.Context, messages []*outbound.OutboxMessage) {
+	if len(messages) == 0 {
+		return
+	}
+	ids := make([]string, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.ID
+	}
+	recordCtx, cancel := recordContext(ctx)
+	defer cancel()
+	if err := p.writeRepository.ReleaseClaims(recordCtx, p.id, ids); err != nil {
+		log.Printf("Failed to release claims on %d messages: %v", len(ids), err)
+	}
+}
+
+// processMessage publishes msg and records a failure. It reports whether the
+// message was published; marking it processed is left to the caller.
+func (p *OutboxProcessor) processMessage(ctx context.Context, msg *outbound.OutboxMessage) bool {
+	publishErr := p.eventPublisher.Publish(ctx, msg)
+	if publishErr == nil {
+		return true
+	}
+
+	if ctx.Err() != nil {
+		// Shutting down; the attempt does not count against the message.
+		p.releaseClaims(ctx, []*outbound.OutboxMessage{msg})
+		return false
+	}
+
+	log.Printf("Failed to publish message %s: %v", msg.ID, publishErr)
+	if msg.RetryCount+1 >= p.config.MaxAttempts {
+		p.deadLetter(ctx, msg, publishErr)
+		return false
+	}
+	recordCtx, cancel := recordContext(ctx)
+	defer cancel()
+	delay := retryDelay(msg.RetryCount, p.config.RetryBaseDelay, p.config.RetryMaxDelay, rand.Float64())
+	if err := p.writeRepository.IncrementRetry(recordCtx, p.id, msg.ID, time.Now().Add(delay), publishErr.Error()); err != nil {
+		log.Printf("Failed to increment retry for %s: %v", msg.ID, err)
+	}
+	return false
+}
+
+// deadLetter parks msg after its last attempt and records a
+// DeadLetterOutboxMessage event in the same transaction, except for messages
+// that are dead-letter events themselves.
+func (p *OutboxProcessor) deadLetter(ctx context.Context, msg *outbound.OutboxMessage, publishErr error) {
+	log.Printf("Dead-lettering message %s after %d attempts", msg.ID, msg.RetryCount+1)
+
+	var deadLetterEvent domain.Event
+	if msg.EventType != event.DeadLetterOutboxMessageEventType {
+		deadLetterEvent = event.NewDeadLetterOutboxMessage(
+			p.idFactory,
+			msg.ID,
+			msg.AggregateID,
+			msg.AggregateType,
+			msg.EventType,
+			msg.RetryCount+1,
+			publishErr.Error(),
+		)
+	}
+	recordCtx, cancel := recordContext(ctx)
+	defer cancel()
+	if err := p.writeRepository.DeadLetter(recordCtx, p.id, msg.ID, publishErr.Error(), deadLetterEvent); err != nil {
+		log.Printf("Failed to dead-letter message %s: %v", msg.ID, err)
+	}
+}
+
+// recordContext is used to record outcomes, so a shutdown in the middle of
+// a batch does not drop the outcome of messages that were already attempted.
+func recordContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), outboxRecordTimeout)
+}
+
+// retryDelay returns the wait after a message failed for the
+// (retryCount+1)th time: base doubled per earlier failure and capped at
+// maxDelay.
+// Half of that delay is fixed and the other half is scaled by jitter in
+// [0, 1), which keeps failing messages from retrying in lockstep.
+func retryDelay(retryCount int, base, maxDelay time.Duration, jitter float64) time.Duration {
+	delay := base
+	for i := 0; i < retryCount && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+	return delay/2 + time.Duration(float64(delay/2)*jitter)
+}
+
+// groupByAggregate splits messages per aggregate, keeping their order.
+func groupByAggregate(messages []*outbound.OutboxMessage) [][]*outbound.OutboxMessage {
+	index := map[string]int{}
+	var groups [][]*outbound.OutboxMessage
+	for _, msg := range messages {
+		key := msg.AggregateType + "/" + msg.AggregateID
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], msg)
+	}
+	return groups
 }
 
 func (p *OutboxProcessor) cleanupLoop(ctx context.Context) {
@@ -0,0 +1,175 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"use-open-workflow.io/engine/internal/port/outbound"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+// newOutboxTestPool returns a pool on a fresh schema with all migrations
+// applied. It skips the test unless TEST_DATABASE_URL is set, as
+// make test-integration does.
+func newOutboxTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set; see make test-integration")
+	}
+	ctx := context.Background()
+
+	admin, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	schema := "outbox_test_" + strings.ToLower(id.NewULIDFactory().New())
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		admin.Close()
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("Failed to drop schema %s: %v", schema, err)
+		}
+		admin.Close()
+	})
+
+	config, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		t.Fatalf("Failed to parse database URL: %v", err)
+	}
+	config.ConnConfig.RuntimeParams["search_path"] = schema
+	config.MaxConns = 20
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	migrations, err := filepath.Glob("../../../migration/*.sql")
+	if err != nil || len(migrations) == 0 {
+		t.Fatalf("Failed to find migrations: %v", err)
+	}
+	slices.Sort(migrations)
+	for _, migration := range migrations {
+		sql, err := os.ReadFile(migration)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", migration, err)
+		}
+		if _, err := pool.Exec(ctx, string(sql)); err != nil {
+			t.Fatalf("Failed to apply %s: %v", migration, err)
+		}
+	}
+
+	return pool
+}
+
+func TestOutboxProcessor_MultipleProcessorsPublishOnce(t *testing.T) {
+	tests := []struct {
+		name    string
+		ordered bool
+		// A publish delay that makes a batch outlast lease checks that the
+		// heartbeat keeps other processors off it.
+		lease        time.Duration
+		publishDelay time.Duration
+	}{
+		{name: "unordered", lease: DefaultConfig().ClaimLease, publishDelay: time.Millisecond},
+		{name: "ordered", ordered: true, lease: DefaultConfig().ClaimLease, publishDelay: time.Millisecond},
+		{name: "unordered batch outlasts lease", lease: 300 * time.Millisecond, publishDelay: 50 * time.Millisecond},
+		{name: "ordered batch outlasts lease", ordered: true, lease: 300 * time.Millisecond, publishDelay: 50 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered := tt.ordered
+			pool := newOutboxTestPool(t)
+			ctx := context.Background()
+
+			const aggregates, eventsPerAggregate = 20, 10
+			createdAt := time.Now()
+			for seq := range eventsPerAggregate {
+				for aggregate := range aggregates {
+					if _, err := pool.Exec(ctx, `
+						INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, payload, created_at)
+						VALUES ($1, $2, 'NodeTemplate', 'UpdateNodeTemplate', '{}', $3)
+					`,
+						fmt.Sprintf("agg%02d-%02d", aggregate, seq),
+						fmt.Sprintf("agg%02d", aggregate),
+						createdAt.Add(time.Duration(seq)*time.Millisecond),
+					); err != nil {
+						t.Fatalf("Failed to insert outbox message: %v", err)
+					}
+				}
+			}
+
+			publisher := &fakeOutboxPublisher{publish: func(msg *outbound.OutboxMessage) {
+				time.Sleep(tt.publishDelay)
+			}}
+			config := DefaultConfig()
+			config.BatchSize = 25
+			config.PollInterval = 20 * time.Millisecond
+			config.Parallelism = 4
+			config.OrderedDelivery = ordered
+			config.ClaimLease = tt.lease
+
+			var processors []*OutboxProcessor
+			for range 4 {
+				processor := NewOutboxProcessor(
+					NewOutboxPostgresWriteRepository(pool),
+					publisher,
+					NewOutboxPostgresNotificationListener(pool),
+					id.NewULIDFactory(),
+					config,
+				)
+				if err := processor.Start(ctx); err != nil {
+					t.Fatalf("Failed to start processor: %v", err)
+				}
+				processors = append(processors, processor)
+			}
+
+			deadline := time.Now().Add(60 * time.Second)
+			for {
+				var pending int
+				if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM outbox WHERE processed_at IS NULL`).Scan(&pending); err != nil {
+					t.Fatalf("Failed to count pending messages: %v", err)
+				}
+				if pending == 0 {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("Timed out with %d messages pending", pending)
+				}
+				time.Sleep(50 * time.Millisecond)
+			}
+			for _, processor := range processors {
+				processor.Stop()
+			}
+
+			if want := aggregates * eventsPerAggregate; len(publisher.published) != want {
+				t.Errorf("Expected %d publishes, got %d", want, len(publisher.published))
+			}
+			seen := map[string]bool{}
+			next := map[string]int{}
+			for _, msgID := range publisher.published {
+				if seen[msgID] {
+					t.Errorf("Message %s published more than once", msgID)
+				}
+				seen[msgID] = true
+
+				aggregateID, seq, _ := strings.Cut(msgID, "-")
+				if want := fmt.Sprintf("%02d", next[aggregateID]); ordered && seq != want {
+					t.Errorf("Expected %s-%s next, got %s", aggregateID, want, msgID)
+				}
+				next[aggregateID]++
+			}
+		})
+	}
+}
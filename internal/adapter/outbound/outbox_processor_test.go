@@ -0,0 +1,516 @@
+package outbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"use-open-workflow.io/engine/internal/domain/outbox/event"
+	"use-open-workflow.io/engine/internal/port/outbound"
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+// fakeOutboxStore hands out a fixed batch on every claim and records what
+// was marked. Like a database, it rejects writes with a cancelled context.
+type fakeOutboxStore struct {
+	mu        sync.Mutex
+	messages  []*outbound.OutboxMessage
+	processed []string
+	retried   []string
+	retries   map[string]outboxRetry
+	released  []string
+	extended  []string
+	markCalls int
+	// deadLettered maps dead-lettered IDs to the event recorded with them.
+	deadLettered map[string]domain.Event
+}
+
+type outboxRetry struct {
+	nextAttemptAt time.Time
+	lastError     string
+}
+
+func (s *fakeOutboxStore) Claim(ctx context.Context, claimedBy string, lease time.Duration, limit int) ([]*outbound.OutboxMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages, nil
+}
+
+func (s *fakeOutboxStore) ClaimInOrder(ctx context.Context, claimedBy string, lease time.Duration, limit int) ([]*outbound.OutboxMessage, error) {
+	return s.Claim(ctx, claimedBy, lease, limit)
+}
+
+func (s *fakeOutboxStore) MarkProcessed(ctx context.Context, claimedBy string, ids []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.processed = append(s.processed, ids...)
+	s.markCalls++
+	return nil
+}
+
+func (s *fakeOutboxStore) IncrementRetry(ctx context.Context, claimedBy string, id string, nextAttemptAt time.Time, lastError string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retried = append(s.retried, id)
+	if s.retries == nil {
+		s.retries = map[string]outboxRetry{}
+	}
+	s.retries[id] = outboxRetry{nextAttemptAt: nextAttemptAt, lastError: lastError}
+	return nil
+}
+
+func (s *fakeOutboxStore) DeadLetter(ctx context.Context, claimedBy string, id string, lastError string, event domain.Event) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deadLettered == nil {
+		s.deadLettered = map[string]domain.Event{}
+	}
+	s.deadLettered[id] = event
+	return nil
+}
+
+func (s *fakeOutboxStore) ExtendClaims(ctx context.Context, claimedBy string, lease time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.extended = append(s.extended, claimedBy)
+	return nil
+}
+
+func (s *fakeOutboxStore) ReleaseClaims(ctx context.Context, claimedBy string, ids []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.released = append(s.released, ids...)
+	return nil
+}
+
+func (s *fakeOutboxStore) Requeue(ctx context.Context, ids []string) (int64, error) {
+	return 0, nil
+}
+
+func (s *fakeOutboxStore) RequeueAll(ctx context.Context, eventType string) (int64, error) {
+	return 0, nil
+}
+
+func (s *fakeOutboxStore) DeleteProcessed(ctx context.Context, olderThan time.Duration) error {
+	return nil
+}
+
+// fakeOutboxPublisher fails the message IDs in fail and records the rest.
+type fakeOutboxPublisher struct {
+	mu        sync.Mutex
+	fail      map[string]bool
+	published []string
+	publish   func(msg *outbound.OutboxMessage)
+}
+
+func (p *fakeOutboxPublisher) Publish(ctx context.Context, msg *outbound.OutboxMessage) error {
+	if p.publish != nil {
+		p.publish(msg)
+	}
+	if p.fail[msg.ID] {
+		return errors.New("subscriber unavailable")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.published = append(p.published, msg.ID)
+	return nil
+}
+
+// fakeOutboxListener signals once it is listening and then for every send
+// on notify.
+type fakeOutboxListener struct {
+	notify chan struct{}
+}
+
+func (l *fakeOutboxListener) Listen(ctx context.Context, wake chan<- struct{}) error {
+	signal(wake)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-l.notify:
+			signal(wake)
+		}
+	}
+}
+
+func outboxMessages(ids ...string) []*outbound.OutboxMessage {
+	messages := make([]*outbound.OutboxMessage, len(ids))
+	for i, id := range ids {
+		// IDs look like "<aggregate><seq>", e.g. a1, a2, b1.
+		messages[i] = &outbound.OutboxMessage{ID: id, AggregateID: id[:1], AggregateType: "NodeTemplate"}
+	}
+	return messages
+}
+
+func TestOutboxProcessor_UnorderedKeepsGoingAfterFailure(t *testing.T) {
+	store := &fakeOutboxStore{messages: outboxMessages("a1", "a2", "b1")}
+	publisher := &fakeOutboxPublisher{fail: map[string]bool{"a1": true}}
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), DefaultConfig())
+
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	slices.Sort(store.processed)
+	if want := []string{"a2", "b1"}; !slices.Equal(store.processed, want) {
+		t.Errorf("Expected processed %v, got %v", want, store.processed)
+	}
+	if want := []string{"a1"}; !slices.Equal(store.retried, want) {
+		t.Errorf("Expected retried %v, got %v", want, store.retried)
+	}
+}
+
+func TestOutboxProcessor_OrderedBlocksAggregateAfterFailure(t *testing.T) {
+	store := &fakeOutboxStore{messages: outboxMessages("a1", "b1", "a2", "b2", "c1")}
+	publisher := &fakeOutboxPublisher{fail: map[string]bool{"a1": true}}
+	config := DefaultConfig()
+	config.OrderedDelivery = true
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	slices.Sort(store.processed)
+	if want := []string{"b1", "b2", "c1"}; !slices.Equal(store.processed, want) {
+		t.Errorf("Expected processed %v, got %v", want, store.processed)
+	}
+	if want := []string{"a1"}; !slices.Equal(store.retried, want) {
+		t.Errorf("Expected retried %v, got %v", want, store.retried)
+	}
+	if slices.Contains(publisher.published, "a2") {
+		t.Error("a2 must not be published while a1 is pending")
+	}
+	if i, j := slices.Index(publisher.published, "b1"), slices.Index(publisher.published, "b2"); i > j {
+		t.Errorf("Expected b1 before b2, got %v", publisher.published)
+	}
+}
+
+func TestOutboxProcessor_OrderedReleasesClaimsAfterFailure(t *testing.T) {
+	store := &fakeOutboxStore{messages: outboxMessages("a1", "a2", "a3", "b1")}
+	publisher := &fakeOutboxPublisher{fail: map[string]bool{"a2": true}}
+	config := DefaultConfig()
+	config.OrderedDelivery = true
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if want := []string{"a3"}; !slices.Equal(store.released, want) {
+		t.Errorf("Expected released %v, got %v", want, store.released)
+	}
+	slices.Sort(store.processed)
+	if want := []string{"a1", "b1"}; !slices.Equal(store.processed, want) {
+		t.Errorf("Expected processed %v, got %v", want, store.processed)
+	}
+}
+
+func TestOutboxProcessor_ExtendsLeaseWhilePublishing(t *testing.T) {
+	store := &fakeOutboxStore{messages: outboxMessages("a1")}
+	publisher := &fakeOutboxPublisher{publish: func(*outbound.OutboxMessage) {
+		time.Sleep(100 * time.Millisecond)
+	}}
+	config := DefaultConfig()
+	config.ClaimLease = 30 * time.Millisecond
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if len(store.extended) < 2 {
+		t.Fatalf("Expected the lease to be extended while publishing, got %d extensions", len(store.extended))
+	}
+	for _, claimedBy := range store.extended {
+		if claimedBy != processor.id {
+			t.Errorf("Expected extensions for %s, got %s", processor.id, claimedBy)
+		}
+	}
+
+	// No extensions once the batch is done.
+	extended := len(store.extended)
+	store.mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	store.mu.Lock()
+	if len(store.extended) != extended {
+		t.Errorf("Expected no extensions after the batch, got %d more", len(store.extended)-extended)
+	}
+}
+
+func TestOutboxProcessor_RecordsOutcomesWhenCancelledMidBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := &fakeOutboxStore{messages: outboxMessages("a1", "a2", "b1")}
+	publisher := &fakeOutboxPublisher{publish: func(msg *outbound.OutboxMessage) {
+		if msg.ID == "a1" {
+			cancel()
+		}
+	}}
+	config := DefaultConfig()
+	config.Parallelism = 1
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	if err := processor.processBatch(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if want := []string{"a1"}; !slices.Equal(store.processed, want) {
+		t.Errorf("Expected processed %v, got %v", want, store.processed)
+	}
+	slices.Sort(store.released)
+	if want := []string{"a2", "b1"}; !slices.Equal(store.released, want) {
+		t.Errorf("Expected released %v, got %v", want, store.released)
+	}
+	if len(store.retried) != 0 {
+		t.Errorf("Expected no retries after cancellation, got %v", store.retried)
+	}
+}
+
+func TestOutboxProcessor_OrderedPublishesAggregatesInParallel(t *testing.T) {
+	store := &fakeOutboxStore{messages: outboxMessages("a1", "b1")}
+
+	// a1 only completes once b1 has started, which deadlocks if the two
+	// aggregates are published one after the other.
+	bStarted := make(chan struct{})
+	publisher := &fakeOutboxPublisher{publish: func(msg *outbound.OutboxMessage) {
+		switch msg.ID {
+		case "a1":
+			select {
+			case <-bStarted:
+			case <-time.After(2 * time.Second):
+			}
+		case "b1":
+			close(bStarted)
+		}
+	}}
+	config := DefaultConfig()
+	config.OrderedDelivery = true
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	start := time.Now()
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected aggregates to be published in parallel, took %v", elapsed)
+	}
+	if len(store.processed) != 2 {
+		t.Errorf("Expected both messages processed, got %v", store.processed)
+	}
+}
+
+func TestOutboxProcessor_SchedulesRetryWithBackoff(t *testing.T) {
+	messages := outboxMessages("a1", "b1")
+	messages[1].RetryCount = 3
+	store := &fakeOutboxStore{messages: messages}
+	publisher := &fakeOutboxPublisher{fail: map[string]bool{"a1": true, "b1": true}}
+	config := DefaultConfig()
+	config.RetryBaseDelay = time.Second
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	before := time.Now()
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		id       string
+		min, max time.Duration
+	}{
+		{"a1", 500 * time.Millisecond, time.Second},
+		{"b1", 4 * time.Second, 8 * time.Second},
+	}
+	for _, tt := range tests {
+		retry, ok := store.retries[tt.id]
+		if !ok {
+			t.Fatalf("Expected retry to be scheduled for %s", tt.id)
+		}
+		if delay := retry.nextAttemptAt.Sub(before); delay < tt.min || delay > tt.max+time.Second {
+			t.Errorf("%s: expected next attempt in [%v, %v], got %v", tt.id, tt.min, tt.max, delay)
+		}
+		if retry.lastError != "subscriber unavailable" {
+			t.Errorf("%s: expected last error to be stored, got %q", tt.id, retry.lastError)
+		}
+	}
+}
+
+func TestOutboxProcessor_DeadLettersAfterMaxAttempts(t *testing.T) {
+	messages := outboxMessages("a1", "b1", "c1")
+	messages[0].RetryCount = 1
+	messages[1].RetryCount = 2
+	messages[1].EventType = "UpdateNodeTemplate"
+	messages[2].RetryCount = 2
+	messages[2].EventType = event.DeadLetterOutboxMessageEventType
+	store := &fakeOutboxStore{messages: messages}
+	publisher := &fakeOutboxPublisher{fail: map[string]bool{"a1": true, "b1": true, "c1": true}}
+	config := DefaultConfig()
+	config.MaxAttempts = 3
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if want := []string{"a1"}; !slices.Equal(store.retried, want) {
+		t.Errorf("Expected retried %v, got %v", want, store.retried)
+	}
+	if len(store.deadLettered) != 2 {
+		t.Fatalf("Expected b1 and c1 dead-lettered, got %v", store.deadLettered)
+	}
+
+	deadLetter, ok := store.deadLettered["b1"].(*event.DeadLetterOutboxMessage)
+	if !ok {
+		t.Fatalf("Expected DeadLetterOutboxMessage event for b1, got %T", store.deadLettered["b1"])
+	}
+	if deadLetter.OutboxID != "b1" || deadLetter.MessageEventType != "UpdateNodeTemplate" || deadLetter.Attempts != 3 {
+		t.Errorf("Unexpected dead-letter event %+v", deadLetter)
+	}
+	if deadLetter.LastError != "subscriber unavailable" {
+		t.Errorf("Expected last error to be recorded, got %q", deadLetter.LastError)
+	}
+
+	if e, ok := store.deadLettered["c1"]; !ok || e != nil {
+		t.Errorf("Expected c1 dead-lettered without a new event, got %v", e)
+	}
+}
+
+func TestOutboxProcessor_NotificationTriggersProcessing(t *testing.T) {
+	store := &fakeOutboxStore{}
+	listener := &fakeOutboxListener{notify: make(chan struct{})}
+	config := DefaultConfig()
+	config.PollInterval = time.Hour
+	processor := NewOutboxProcessor(store, &fakeOutboxPublisher{}, listener, id.NewULIDFactory(), config)
+
+	if err := processor.Start(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer processor.Stop()
+
+	store.mu.Lock()
+	store.messages = outboxMessages("a1")
+	store.mu.Unlock()
+	listener.notify <- struct{}{}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		store.mu.Lock()
+		processed := slices.Contains(store.processed, "a1")
+		store.mu.Unlock()
+		if processed {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected notification to process a1 before the next poll")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestOutboxProcessor_BoundsParallelismAndBatchesMarks(t *testing.T) {
+	store := &fakeOutboxStore{messages: outboxMessages("a1", "b1", "c1", "d1", "e1", "e2")}
+
+	var mu sync.Mutex
+	var active, peak int
+	publisher := &fakeOutboxPublisher{publish: func(msg *outbound.OutboxMessage) {
+		mu.Lock()
+		active++
+		peak = max(peak, active)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+	}}
+	config := DefaultConfig()
+	config.Parallelism = 2
+	processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+	if err := processor.processBatch(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if peak != 2 {
+		t.Errorf("Expected 2 concurrent publishes, got %d", peak)
+	}
+	if len(store.processed) != 6 || store.markCalls != 1 {
+		t.Errorf("Expected 6 messages marked in one call, got %v in %d calls", store.processed, store.markCalls)
+	}
+	if i, j := slices.Index(publisher.published, "e1"), slices.Index(publisher.published, "e2"); i > j {
+		t.Errorf("Expected e1 before e2, got %v", publisher.published)
+	}
+}
+
+// BenchmarkOutboxProcessor_SlowPublisher publishes batches of 100 messages
+// over 50 aggregates to a publisher that takes 1ms per message.
+func BenchmarkOutboxProcessor_SlowPublisher(b *testing.B) {
+	ids := make([]string, 100)
+	for i := range ids {
+		// outboxMessages derives the aggregate from the first character.
+		ids[i] = fmt.Sprintf("%c%d", rune('A'+i%50), i)
+	}
+
+	for _, parallelism := range []int{1, 8, 32} {
+		b.Run(fmt.Sprintf("parallelism=%d", parallelism), func(b *testing.B) {
+			store := &fakeOutboxStore{messages: outboxMessages(ids...)}
+			publisher := &fakeOutboxPublisher{publish: func(msg *outbound.OutboxMessage) {
+				time.Sleep(time.Millisecond)
+			}}
+			config := DefaultConfig()
+			config.Parallelism = parallelism
+			processor := NewOutboxProcessor(store, publisher, nil, id.NewULIDFactory(), config)
+
+			for b.Loop() {
+				if err := processor.processBatch(context.Background()); err != nil {
+					b.Fatalf("Expected no error, got %v", err)
+				}
+			}
+			b.ReportMetric(float64(b.N*len(ids))/b.Elapsed().Seconds(), "msgs/s")
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryCount int
+		jitter     float64
+		want       time.Duration
+	}{
+		{"first retry without jitter", 0, 0, 2500 * time.Millisecond},
+		{"first retry with full jitter", 0, 0.999999, 5 * time.Second},
+		{"doubles per attempt", 2, 0, 10 * time.Second},
+		{"capped at max", 20, 0, 30 * time.Minute},
+		{"no overflow for large counts", 100, 0, 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := retryDelay(tt.retryCount, 5*time.Second, time.Hour, tt.jitter)
+			if diff := got - tt.want; diff < -time.Millisecond || diff > time.Millisecond {
+				t.Errorf("retryDelay(%d, %v) = %v, want %v", tt.retryCount, tt.jitter, got, tt.want)
+			}
+		})
+	}
+}
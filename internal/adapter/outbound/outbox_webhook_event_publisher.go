@@ -0,0 +1,152 @@
+package outbound
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"use-open-workflow.io/engine/internal/port/outbound"
+)
+
+const (
+	WebhookIdempotencyKeyHeader = "Idempotency-Key"
+	WebhookEventTypeHeader      = "X-Event-Type"
+	WebhookTimestampHeader      = "X-Webhook-Timestamp"
+	WebhookSignatureHeader      = "X-Webhook-Signature"
+
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+// WebhookSubscriber is an endpoint that receives every outbox message.
+type WebhookSubscriber struct {
+	URL     string
+	Secret  string
+	Timeout time.Duration
+}
+
+// webhookEnvelope is the request body sent to subscribers.
+type webhookEnvelope struct {
+	ID            string          `json:"id"`
+	AggregateID   string          `json:"aggregate_id"`
+	AggregateType string          `json:"aggregate_type"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// WebhookClient sends outbox messages to webhook subscribers. Each request
+// carries the message ID as idempotency key and, when the subscriber has a
+// secret, an HMAC signature.
+type WebhookClient struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewWebhookClient() *WebhookClient {
+	return &WebhookClient{
+		client: &http.Client{},
+		now:    time.Now,
+	}
+}
+
+// Deliver POSTs msg to subscriber. Transport failures and non-2xx responses
+// are returned as errors.
+func (c *WebhookClient) Deliver(ctx context.Context, subscriber WebhookSubscriber, msg *outbound.OutboxMessage) error {
+	body, err := webhookBody(msg)
+	if err != nil {
+		return err
+	}
+
+	timeout := subscriber.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscriber.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(c.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIdempotencyKeyHeader, msg.ID)
+	req.Header.Set(WebhookEventTypeHeader, msg.EventType)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	if subscriber.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhook(subscriber.Secret, timestamp, body))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// OutboxWebhookEventPublisher POSTs each message to a static list of
+// subscribers. A failure for one subscriber retries the message for all of
+// them, which the idempotency key lets receivers absorb.
+type OutboxWebhookEventPublisher struct {
+	subscribers []WebhookSubscriber
+	client      *WebhookClient
+}
+
+func NewOutboxWebhookEventPublisher(subscribers []WebhookSubscriber) *OutboxWebhookEventPublisher {
+	return &OutboxWebhookEventPublisher{
+		subscribers: subscribers,
+		client:      NewWebhookClient(),
+	}
+}
+
+func (p *OutboxWebhookEventPublisher) Publish(ctx context.Context, msg *outbound.OutboxMessage) error {
+	var errs []error
+	for _, subscriber := range p.subscribers {
+		if err := p.client.Deliver(ctx, subscriber, msg); err != nil {
+			errs = append(errs, fmt.Errorf("webhook %s: %w", subscriber.URL, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SignWebhook returns the signature header value for a request body:
+// "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>".
+// Subscribers recompute it with their secret to authenticate the request.
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func webhookBody(msg *outbound.OutboxMessage) ([]byte, error) {
+	body, err := json.Marshal(webhookEnvelope{
+		ID:            msg.ID,
+		AggregateID:   msg.AggregateID,
+		AggregateType: msg.AggregateType,
+		EventType:     msg.EventType,
+		Payload:       msg.Payload,
+		CreatedAt:     msg.CreatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook body: %w", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,119 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"use-open-workflow.io/engine/internal/port/outbound"
+)
+
+func testOutboxMessage() *outbound.OutboxMessage {
+	return &outbound.OutboxMessage{
+		ID:            "01HZX0000000000000000000MS",
+		AggregateID:   "01HZX0000000000000000000AG",
+		AggregateType: "NodeTemplate",
+		EventType:     "CreateNodeTemplate",
+		Payload:       []byte(`{"name":"http"}`),
+		CreatedAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestOutboxWebhookEventPublisher_SignsRequest(t *testing.T) {
+	var (
+		gotHeader http.Header
+		gotBody   []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	publisher := NewOutboxWebhookEventPublisher([]WebhookSubscriber{{URL: server.URL, Secret: "s3cret"}})
+	publisher.client.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	msg := testOutboxMessage()
+	if err := publisher.Publish(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := gotHeader.Get(WebhookIdempotencyKeyHeader); got != msg.ID {
+		t.Errorf("Expected idempotency key %s, got %s", msg.ID, got)
+	}
+	if got := gotHeader.Get(WebhookEventTypeHeader); got != "CreateNodeTemplate" {
+		t.Errorf("Expected event type header CreateNodeTemplate, got %s", got)
+	}
+	if got := gotHeader.Get(WebhookTimestampHeader); got != "1700000000" {
+		t.Errorf("Expected timestamp 1700000000, got %s", got)
+	}
+	if got, want := gotHeader.Get(WebhookSignatureHeader), SignWebhook("s3cret", "1700000000", gotBody); got != want {
+		t.Errorf("Expected signature %s, got %s", want, got)
+	}
+
+	var envelope map[string]any
+	if err := json.Unmarshal(gotBody, &envelope); err != nil {
+		t.Fatalf("Invalid body %s: %v", gotBody, err)
+	}
+	if envelope["aggregate_id"] != msg.AggregateID || envelope["event_type"] != msg.EventType {
+		t.Errorf("Unexpected envelope %s", gotBody)
+	}
+	if payload, _ := envelope["payload"].(map[string]any); payload["name"] != "http" {
+		t.Errorf("Expected payload to be embedded as JSON, got %s", gotBody)
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac key
+	want := "sha256=9d713ed406bb7076d4123f0dc2c39d2df5c654ed4b0cd56b52c8b4c940bd63ae"
+	if got := SignWebhook("key", "1700000000", []byte("{}")); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestOutboxWebhookEventPublisher_Non2xxIsError(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ok.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	publisher := NewOutboxWebhookEventPublisher([]WebhookSubscriber{{URL: ok.URL}, {URL: failing.URL}})
+
+	err := publisher.Publish(context.Background(), testOutboxMessage())
+	if err == nil {
+		t.Fatal("Expected error for 503 response")
+	}
+	if !strings.Contains(err.Error(), failing.URL) || strings.Contains(err.Error(), ok.URL) {
+		t.Errorf("Expected error to name only the failing subscriber, got %v", err)
+	}
+}
+
+func TestOutboxWebhookEventPublisher_PerSubscriberTimeout(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	publisher := NewOutboxWebhookEventPublisher([]WebhookSubscriber{{URL: slow.URL, Timeout: 50 * time.Millisecond}})
+
+	start := time.Now()
+	if err := publisher.Publish(context.Background(), testOutboxMessage()); err == nil {
+		t.Fatal("Expected timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected subscriber timeout to apply, took %v", elapsed)
+	}
+}
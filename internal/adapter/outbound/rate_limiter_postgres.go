@@ -0,0 +1,50 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RateLimiterPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewRateLimiterPostgres(pool *pgxpool.Pool) *RateLimiterPostgres {
+	return &RateLimiterPostgres{pool: pool}
+}
+
+// Reserve refills the bucket for the time elapsed since its last update,
+// caps it at burst and takes one token, all in a single statement so that
+// concurrent callers serialize on the row. The balance may go negative: a
+// negative balance is a queue of reservations waiting for future tokens.
+func (r *RateLimiterPostgres) Reserve(ctx context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	var tokens float64
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO rate_limit_bucket (key, tokens, updated_at)
+		VALUES ($1, $3::DOUBLE PRECISION - 1, clock_timestamp())
+		ON CONFLICT (key) DO UPDATE
+		SET tokens = LEAST(
+				$3::DOUBLE PRECISION,
+				rate_limit_bucket.tokens + EXTRACT(EPOCH FROM clock_timestamp() - rate_limit_bucket.updated_at) * $2
+			) - 1,
+			updated_at = clock_timestamp()
+		RETURNING tokens
+	`, key, rate, burst).Scan(&tokens)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve rate limit token: %w", err)
+	}
+
+	return reservationDelay(tokens, rate), nil
+}
+
+// reservationDelay converts a bucket balance after a reservation into the
+// time until the reserved token becomes available.
+func reservationDelay(tokens float64, rate float64) time.Duration {
+	if tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-tokens / rate * float64(time.Second))
+}
@@ -0,0 +1,28 @@
+package outbound
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReservationDelay(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens float64
+		rate   float64
+		want   time.Duration
+	}{
+		{"tokens left", 3, 10, 0},
+		{"last token", 0, 10, 0},
+		{"one reservation queued", -1, 10, 100 * time.Millisecond},
+		{"five reservations queued", -5, 2, 2500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reservationDelay(tt.tokens, tt.rate); got != tt.want {
+				t.Errorf("reservationDelay(%v, %v) = %v, want %v", tt.tokens, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
@@ -103,31 +103,58 @@ func (u *UnitOfWorkPostgres) GetTx(ctx context.Context) (pgx.Tx, bool) {
 func (u *UnitOfWorkPostgres) persistOutboxEvents(ctx context.Context, tx pgx.Tx) error {
 	allAggregates := append(append(u.newItems, u.dirty...), u.deleted...)
 
+	inserted := false
 	for _, aggregate := range allAggregates {
 		events := aggregate.Events()
 		for _, event := range events {
-			payload, err := json.Marshal(event)
-			if err != nil {
-				return fmt.Errorf("failed to marshal event: %w", err)
-			}
-
-			_, err = tx.Exec(ctx, `
-				INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, payload, created_at)
-				VALUES ($1, $2, $3, $4, $5, $6)
-			`,
-				event.ID(),
-				event.AggregateID(),
-				event.AggregateType(),
-				event.EventType(),
-				payload,
-				event.OccurredAt(),
-			)
-			if err != nil {
-				return fmt.Errorf("failed to insert outbox event: %w", err)
+			if err := insertOutboxEvent(ctx, tx, event); err != nil {
+				return err
 			}
+			inserted = true
 		}
 	}
 
+	if !inserted {
+		return nil
+	}
+	return notifyOutbox(ctx, tx)
+}
+
+// execer is satisfied by both pgx.Tx and *pgxpool.Pool.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// notifyOutbox wakes listening outbox processors. Postgres delivers the
+// notification only when tx commits, so the new rows are visible by then.
+func notifyOutbox(ctx context.Context, q execer) error {
+	if _, err := q.Exec(ctx, `SELECT pg_notify($1, '')`, OutboxNotifyChannel); err != nil {
+		return fmt.Errorf("failed to notify outbox: %w", err)
+	}
+	return nil
+}
+
+func insertOutboxEvent(ctx context.Context, tx pgx.Tx, event domain.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`,
+		event.ID(),
+		event.AggregateID(),
+		event.AggregateType(),
+		event.EventType(),
+		payload,
+		event.OccurredAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert outbox event: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,90 @@
+package inbound
+
+import (
+	"context"
+	"encoding/json"
+
+	"use-open-workflow.io/engine/internal/port/outbound"
+	"use-open-workflow.io/engine/internal/port/outbox/inbound"
+)
+
+type OutboxDeadLetterService struct {
+	readRepository  outbound.OutboxReadRepository
+	writeRepository outbound.OutboxWriteRepository
+}
+
+func NewOutboxDeadLetterService(
+	readRepository outbound.OutboxReadRepository,
+	writeRepository outbound.OutboxWriteRepository,
+) *OutboxDeadLetterService {
+	return &OutboxDeadLetterService{
+		readRepository:  readRepository,
+		writeRepository: writeRepository,
+	}
+}
+
+func (s *OutboxDeadLetterService) List(ctx context.Context, limit, offset int) ([]*inbound.OutboxDeadLetterDTO, error) {
+	messages, err := s.readRepository.FindDeadLettered(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetterDTOs := make([]*inbound.OutboxDeadLetterDTO, len(messages))
+	for i, msg := range messages {
+		deadLetterDTOs[i] = toOutboxDeadLetterDTO(msg)
+	}
+	return deadLetterDTOs, nil
+}
+
+func (s *OutboxDeadLetterService) GetByID(ctx context.Context, id string) (*inbound.OutboxDeadLetterDTO, error) {
+	msg, err := s.readRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil || msg.DeadLetteredAt == nil {
+		return nil, nil
+	}
+	return toOutboxDeadLetterDTO(msg), nil
+}
+
+func (s *OutboxDeadLetterService) Requeue(ctx context.Context, id string) (bool, error) {
+	requeued, err := s.writeRepository.Requeue(ctx, []string{id})
+	if err != nil {
+		return false, err
+	}
+	return requeued > 0, nil
+}
+
+func (s *OutboxDeadLetterService) RequeueMany(ctx context.Context, input inbound.RequeueOutboxDeadLettersInput) (*inbound.RequeueOutboxDeadLettersResult, error) {
+	var requeued int64
+	var err error
+	switch {
+	case input.All:
+		requeued, err = s.writeRepository.RequeueAll(ctx, input.EventType)
+	case len(input.IDs) > 0:
+		requeued, err = s.writeRepository.Requeue(ctx, input.IDs)
+	default:
+		return nil, inbound.ErrNoDeadLettersSelected
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inbound.RequeueOutboxDeadLettersResult{Requeued: requeued}, nil
+}
+
+func toOutboxDeadLetterDTO(msg *outbound.OutboxMessage) *inbound.OutboxDeadLetterDTO {
+	dto := &inbound.OutboxDeadLetterDTO{
+		ID:            msg.ID,
+		AggregateID:   msg.AggregateID,
+		AggregateType: msg.AggregateType,
+		EventType:     msg.EventType,
+		Payload:       json.RawMessage(msg.Payload),
+		Attempts:      msg.RetryCount,
+		LastError:     msg.LastError,
+		CreatedAt:     msg.CreatedAt,
+	}
+	if msg.DeadLetteredAt != nil {
+		dto.DeadLetteredAt = *msg.DeadLetteredAt
+	}
+	return dto
+}
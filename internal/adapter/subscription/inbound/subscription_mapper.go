@@ -0,0 +1,46 @@
+package inbound
+
+import (
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+	"use-open-workflow.io/engine/internal/port/subscription/inbound"
+	"use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+type SubscriptionMapper struct{}
+
+func NewSubscriptionMapper() *SubscriptionMapper {
+	return &SubscriptionMapper{}
+}
+
+func (m *SubscriptionMapper) To(subscription *aggregate.Subscription) (*inbound.SubscriptionDTO, error) {
+	eventTypes := subscription.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	return &inbound.SubscriptionDTO{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		HasSecret:  subscription.Secret != "",
+		Enabled:    subscription.Enabled,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}, nil
+}
+
+func (m *SubscriptionMapper) DeliveryTo(delivery *outbound.SubscriptionDelivery) (*inbound.SubscriptionDeliveryDTO, error) {
+	return &inbound.SubscriptionDeliveryDTO{
+		OutboxID:    delivery.OutboxID,
+		EventType:   delivery.EventType,
+		Status:      delivery.Status,
+		Attempts:    delivery.Attempts,
+		LastError:   delivery.LastError,
+		DeliveredAt: delivery.DeliveredAt,
+		UpdatedAt:   delivery.UpdatedAt,
+	}, nil
+}
+
+func (m *SubscriptionMapper) EndpointFrom(url string) (*aggregate.Endpoint, error) {
+	return aggregate.NewEndpoint(url)
+}
@@ -0,0 +1,100 @@
+package inbound
+
+import (
+	"context"
+	"fmt"
+
+	"use-open-workflow.io/engine/internal/port/outbound"
+	"use-open-workflow.io/engine/internal/port/subscription/inbound"
+	subscriptionOutbound "use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+const deliveryListLimit = 100
+
+type SubscriptionReadService struct {
+	uowFactory            outbound.UnitOfWorkFactory
+	readRepositoryFactory subscriptionOutbound.SubscriptionReadRepositoryFactory
+	deliveryRepository    subscriptionOutbound.SubscriptionDeliveryRepository
+	mapper                inbound.SubscriptionMapper
+}
+
+func NewSubscriptionReadService(
+	uowFactory outbound.UnitOfWorkFactory,
+	readRepositoryFactory subscriptionOutbound.SubscriptionReadRepositoryFactory,
+	deliveryRepository subscriptionOutbound.SubscriptionDeliveryRepository,
+	mapper inbound.SubscriptionMapper,
+) *SubscriptionReadService {
+	return &SubscriptionReadService{
+		uowFactory:            uowFactory,
+		readRepositoryFactory: readRepositoryFactory,
+		deliveryRepository:    deliveryRepository,
+		mapper:                mapper,
+	}
+}
+
+func (s *SubscriptionReadService) List(ctx context.Context) ([]*inbound.SubscriptionDTO, error) {
+	uow := s.uowFactory.Create()
+	readRepo := s.readRepositoryFactory.Create(uow)
+
+	subscriptions, err := readRepo.FindMany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionDTOs := make([]*inbound.SubscriptionDTO, len(subscriptions))
+	for i, v := range subscriptions {
+		subscriptionDTO, err := s.mapper.To(v)
+		if err != nil {
+			return nil, err
+		}
+		subscriptionDTOs[i] = subscriptionDTO
+	}
+
+	return subscriptionDTOs, nil
+}
+
+func (s *SubscriptionReadService) GetByID(ctx context.Context, id string) (*inbound.SubscriptionDTO, error) {
+	uow := s.uowFactory.Create()
+	readRepo := s.readRepositoryFactory.Create(uow)
+
+	subscription, err := readRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, nil
+	}
+
+	return s.mapper.To(subscription)
+}
+
+// ListDeliveries returns the most recent deliveries of a subscription, or nil
+// if the subscription does not exist.
+func (s *SubscriptionReadService) ListDeliveries(ctx context.Context, id string) ([]*inbound.SubscriptionDeliveryDTO, error) {
+	uow := s.uowFactory.Create()
+	readRepo := s.readRepositoryFactory.Create(uow)
+
+	subscription, err := readRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, nil
+	}
+
+	deliveries, err := s.deliveryRepository.FindBySubscription(ctx, id, deliveryListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deliveries: %w", err)
+	}
+
+	deliveryDTOs := make([]*inbound.SubscriptionDeliveryDTO, len(deliveries))
+	for i, v := range deliveries {
+		deliveryDTO, err := s.mapper.DeliveryTo(v)
+		if err != nil {
+			return nil, err
+		}
+		deliveryDTOs[i] = deliveryDTO
+	}
+
+	return deliveryDTOs, nil
+}
@@ -0,0 +1,165 @@
+package inbound
+
+import (
+	"context"
+	"fmt"
+
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+	"use-open-workflow.io/engine/internal/port/outbound"
+	"use-open-workflow.io/engine/internal/port/subscription/inbound"
+	subscriptionOutbound "use-open-workflow.io/engine/internal/port/subscription/outbound"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type SubscriptionWriteService struct {
+	uowFactory             outbound.UnitOfWorkFactory
+	writeRepositoryFactory subscriptionOutbound.SubscriptionWriteRepositoryFactory
+	readRepositoryFactory  subscriptionOutbound.SubscriptionReadRepositoryFactory
+	factory                *aggregate.SubscriptionFactory
+	mapper                 inbound.SubscriptionMapper
+	idFactory              id.Factory
+}
+
+func NewSubscriptionWriteService(
+	uowFactory outbound.UnitOfWorkFactory,
+	writeRepositoryFactory subscriptionOutbound.SubscriptionWriteRepositoryFactory,
+	readRepositoryFactory subscriptionOutbound.SubscriptionReadRepositoryFactory,
+	factory *aggregate.SubscriptionFactory,
+	mapper inbound.SubscriptionMapper,
+	idFactory id.Factory,
+) *SubscriptionWriteService {
+	return &SubscriptionWriteService{
+		uowFactory:             uowFactory,
+		writeRepositoryFactory: writeRepositoryFactory,
+		readRepositoryFactory:  readRepositoryFactory,
+		factory:                factory,
+		mapper:                 mapper,
+		idFactory:              idFactory,
+	}
+}
+
+func (s *SubscriptionWriteService) Create(ctx context.Context, input inbound.CreateSubscriptionInput) (*inbound.SubscriptionDTO, error) {
+	endpoint, err := s.mapper.EndpointFrom(input.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	enabled := true
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	uow := s.uowFactory.Create()
+
+	// Create repository bound to THIS UoW
+	writeRepo := s.writeRepositoryFactory.Create(uow)
+
+	txCtx, err := uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			uow.Rollback(txCtx)
+		}
+	}()
+
+	subscription := s.factory.Make(endpoint, input.EventTypes, input.Secret, enabled)
+
+	// Save using the UoW-bound repository
+	if err = writeRepo.Save(txCtx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to save subscription: %w", err)
+	}
+
+	if err = uow.Commit(txCtx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return s.mapper.To(subscription)
+}
+
+func (s *SubscriptionWriteService) Update(ctx context.Context, id string, input inbound.UpdateSubscriptionInput) (*inbound.SubscriptionDTO, error) {
+	endpoint, err := s.mapper.EndpointFrom(input.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	uow := s.uowFactory.Create()
+
+	// Create repositories bound to THIS UoW
+	writeRepo := s.writeRepositoryFactory.Create(uow)
+	readRepo := s.readRepositoryFactory.Create(uow)
+
+	txCtx, err := uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			uow.Rollback(txCtx)
+		}
+	}()
+
+	subscription, err := readRepo.FindByID(txCtx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	if subscription == nil {
+		err = fmt.Errorf("subscription not found: %s", id)
+		return nil, err
+	}
+
+	subscription.Update(s.idFactory, endpoint, input.EventTypes)
+	if input.Secret != "" {
+		subscription.RotateSecret(s.idFactory, input.Secret)
+	}
+	if input.Enabled != nil {
+		if *input.Enabled {
+			subscription.Enable(s.idFactory)
+		} else {
+			subscription.Disable(s.idFactory)
+		}
+	}
+
+	// Update using UoW-bound repository
+	if err = writeRepo.Update(txCtx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	if err = uow.Commit(txCtx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return s.mapper.To(subscription)
+}
+
+func (s *SubscriptionWriteService) Delete(ctx context.Context, id string) error {
+	uow := s.uowFactory.Create()
+
+	// Create repository bound to THIS UoW
+	writeRepo := s.writeRepositoryFactory.Create(uow)
+
+	txCtx, err := uow.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			uow.Rollback(txCtx)
+		}
+	}()
+
+	// Delete using UoW-bound repository
+	if err = writeRepo.Delete(txCtx, id); err != nil {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+
+	if err = uow.Commit(txCtx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
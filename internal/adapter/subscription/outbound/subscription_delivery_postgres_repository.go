@@ -0,0 +1,111 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+type SubscriptionDeliveryPostgresRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSubscriptionDeliveryPostgresRepository(pool *pgxpool.Pool) *SubscriptionDeliveryPostgresRepository {
+	return &SubscriptionDeliveryPostgresRepository{pool: pool}
+}
+
+func (r *SubscriptionDeliveryPostgresRepository) FindDelivered(ctx context.Context, outboxID string) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT subscription_id
+		FROM subscription_delivery
+		WHERE outbox_id = $1 AND status = $2
+	`, outboxID, outbound.SubscriptionDeliveryStatusDelivered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptionIDs []string
+	for rows.Next() {
+		var subscriptionID string
+		if err := rows.Scan(&subscriptionID); err != nil {
+			return nil, fmt.Errorf("failed to scan delivery: %w", err)
+		}
+		subscriptionIDs = append(subscriptionIDs, subscriptionID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return subscriptionIDs, nil
+}
+
+func (r *SubscriptionDeliveryPostgresRepository) RecordAttempt(ctx context.Context, subscriptionID, outboxID, eventType string, deliveryErr error) error {
+	status := outbound.SubscriptionDeliveryStatusDelivered
+	var lastError *string
+	if deliveryErr != nil {
+		status = outbound.SubscriptionDeliveryStatusFailed
+		msg := deliveryErr.Error()
+		lastError = &msg
+	}
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO subscription_delivery (subscription_id, outbox_id, event_type, status, attempts, last_error, delivered_at, updated_at)
+		VALUES ($1, $2, $3, $4, 1, $5, CASE WHEN $6 THEN NOW() END, NOW())
+		ON CONFLICT (subscription_id, outbox_id) DO UPDATE
+		SET status = EXCLUDED.status,
+			attempts = subscription_delivery.attempts + 1,
+			last_error = EXCLUDED.last_error,
+			delivered_at = EXCLUDED.delivered_at,
+			updated_at = EXCLUDED.updated_at
+	`, subscriptionID, outboxID, eventType, status, lastError, deliveryErr == nil)
+	if err != nil {
+		return fmt.Errorf("failed to record delivery attempt: %w", err)
+	}
+	return nil
+}
+
+func (r *SubscriptionDeliveryPostgresRepository) FindBySubscription(ctx context.Context, subscriptionID string, limit int) ([]*outbound.SubscriptionDelivery, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT subscription_id, outbox_id, event_type, status, attempts, last_error, delivered_at, updated_at
+		FROM subscription_delivery
+		WHERE subscription_id = $1
+		ORDER BY updated_at DESC
+		LIMIT $2
+	`, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*outbound.SubscriptionDelivery
+	for rows.Next() {
+		delivery := &outbound.SubscriptionDelivery{}
+		var lastError *string
+		if err := rows.Scan(
+			&delivery.SubscriptionID,
+			&delivery.OutboxID,
+			&delivery.EventType,
+			&delivery.Status,
+			&delivery.Attempts,
+			&lastError,
+			&delivery.DeliveredAt,
+			&delivery.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan delivery: %w", err)
+		}
+		if lastError != nil {
+			delivery.LastError = *lastError
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,91 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+	portOutbound "use-open-workflow.io/engine/internal/port/outbound"
+)
+
+type SubscriptionPostgresReadRepository struct {
+	uow portOutbound.UnitOfWork
+}
+
+func NewSubscriptionPostgresReadRepository(
+	uow portOutbound.UnitOfWork,
+) *SubscriptionPostgresReadRepository {
+	return &SubscriptionPostgresReadRepository{
+		uow: uow,
+	}
+}
+
+func (r *SubscriptionPostgresReadRepository) FindMany(ctx context.Context) ([]*aggregate.Subscription, error) {
+	return r.query(ctx, `
+		SELECT id, url, event_types, secret, enabled, created_at, updated_at
+		FROM subscription
+		ORDER BY created_at DESC
+	`)
+}
+
+func (r *SubscriptionPostgresReadRepository) FindMatching(ctx context.Context, eventType string) ([]*aggregate.Subscription, error) {
+	return r.query(ctx, `
+		SELECT id, url, event_types, secret, enabled, created_at, updated_at
+		FROM subscription
+		WHERE enabled AND (cardinality(event_types) = 0 OR $1 = ANY(event_types))
+		ORDER BY created_at ASC
+	`, eventType)
+}
+
+func (r *SubscriptionPostgresReadRepository) FindByID(ctx context.Context, id string) (*aggregate.Subscription, error) {
+	q := r.uow.Querier(ctx)
+
+	var url, secret string
+	var eventTypes []string
+	var enabled bool
+	var createdAt, updatedAt time.Time
+	err := q.QueryRow(ctx, `
+		SELECT id, url, event_types, secret, enabled, created_at, updated_at
+		FROM subscription
+		WHERE id = $1
+	`, id).Scan(&id, &url, &eventTypes, &secret, &enabled, &createdAt, &updatedAt)
+
+	if err != nil && err.Error() == "no rows in result set" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subscription: %w", err)
+	}
+
+	return aggregate.ReconstituteSubscription(id, url, eventTypes, secret, enabled, createdAt, updatedAt), nil
+}
+
+func (r *SubscriptionPostgresReadRepository) query(ctx context.Context, sql string, args ...any) ([]*aggregate.Subscription, error) {
+	q := r.uow.Querier(ctx)
+
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*aggregate.Subscription
+	for rows.Next() {
+		var id, url, secret string
+		var eventTypes []string
+		var enabled bool
+		var createdAt, updatedAt time.Time
+		if err := rows.Scan(&id, &url, &eventTypes, &secret, &enabled, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription: %w", err)
+		}
+
+		subscriptions = append(subscriptions, aggregate.ReconstituteSubscription(id, url, eventTypes, secret, enabled, createdAt, updatedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,16 @@
+package outbound
+
+import (
+	"use-open-workflow.io/engine/internal/port/outbound"
+	subscriptionOutbound "use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+type SubscriptionPostgresReadRepositoryFactory struct{}
+
+func NewSubscriptionPostgresReadRepositoryFactory() *SubscriptionPostgresReadRepositoryFactory {
+	return &SubscriptionPostgresReadRepositoryFactory{}
+}
+
+func (f *SubscriptionPostgresReadRepositoryFactory) Create(uow outbound.UnitOfWork) subscriptionOutbound.SubscriptionReadRepository {
+	return NewSubscriptionPostgresReadRepository(uow)
+}
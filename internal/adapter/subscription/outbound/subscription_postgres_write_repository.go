@@ -0,0 +1,79 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+	portOutbound "use-open-workflow.io/engine/internal/port/outbound"
+)
+
+type SubscriptionPostgresWriteRepository struct {
+	uow portOutbound.UnitOfWork
+}
+
+func NewSubscriptionPostgresWriteRepository(
+	uow portOutbound.UnitOfWork,
+) *SubscriptionPostgresWriteRepository {
+	return &SubscriptionPostgresWriteRepository{
+		uow: uow,
+	}
+}
+
+func (r *SubscriptionPostgresWriteRepository) Save(ctx context.Context, subscription *aggregate.Subscription) error {
+	q := r.uow.Querier(ctx)
+
+	_, err := q.Exec(ctx, `
+		INSERT INTO subscription (id, url, event_types, secret, enabled, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, subscription.ID, subscription.URL, eventTypesColumn(subscription.EventTypes), subscription.Secret, subscription.Enabled, subscription.CreatedAt, subscription.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to save subscription: %w", err)
+	}
+
+	r.uow.RegisterNew(subscription)
+
+	return nil
+}
+
+func (r *SubscriptionPostgresWriteRepository) Update(ctx context.Context, subscription *aggregate.Subscription) error {
+	q := r.uow.Querier(ctx)
+
+	_, err := q.Exec(ctx, `
+		UPDATE subscription
+		SET url = $1, event_types = $2, secret = $3, enabled = $4, updated_at = $5
+		WHERE id = $6
+	`, subscription.URL, eventTypesColumn(subscription.EventTypes), subscription.Secret, subscription.Enabled, subscription.UpdatedAt, subscription.ID)
+
+	if err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	r.uow.RegisterDirty(subscription)
+
+	return nil
+}
+
+func (r *SubscriptionPostgresWriteRepository) Delete(ctx context.Context, id string) error {
+	q := r.uow.Querier(ctx)
+
+	_, err := q.Exec(ctx, `
+		DELETE FROM subscription
+		WHERE id = $1
+	`, id)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+
+	return nil
+}
+
+// eventTypesColumn stores "no filter" as an empty array rather than NULL.
+func eventTypesColumn(eventTypes []string) []string {
+	if eventTypes == nil {
+		return []string{}
+	}
+	return eventTypes
+}
@@ -0,0 +1,16 @@
+package outbound
+
+import (
+	"use-open-workflow.io/engine/internal/port/outbound"
+	subscriptionOutbound "use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+type SubscriptionPostgresWriteRepositoryFactory struct{}
+
+func NewSubscriptionPostgresWriteRepositoryFactory() *SubscriptionPostgresWriteRepositoryFactory {
+	return &SubscriptionPostgresWriteRepositoryFactory{}
+}
+
+func (f *SubscriptionPostgresWriteRepositoryFactory) Create(uow outbound.UnitOfWork) subscriptionOutbound.SubscriptionWriteRepository {
+	return NewSubscriptionPostgresWriteRepository(uow)
+}
@@ -0,0 +1,78 @@
+package outbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	adapterOutbound "use-open-workflow.io/engine/internal/adapter/outbound"
+	"use-open-workflow.io/engine/internal/port/outbound"
+	subscriptionOutbound "use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+// SubscriptionWebhookEventPublisher fans each outbox message out to the
+// enabled subscriptions whose event-type filter matches. Delivery state is
+// tracked per subscription, so a retry only reaches the subscriptions that
+// have not received the message yet.
+type SubscriptionWebhookEventPublisher struct {
+	uowFactory            outbound.UnitOfWorkFactory
+	readRepositoryFactory subscriptionOutbound.SubscriptionReadRepositoryFactory
+	deliveryRepository    subscriptionOutbound.SubscriptionDeliveryRepository
+	client                *adapterOutbound.WebhookClient
+}
+
+func NewSubscriptionWebhookEventPublisher(
+	uowFactory outbound.UnitOfWorkFactory,
+	readRepositoryFactory subscriptionOutbound.SubscriptionReadRepositoryFactory,
+	deliveryRepository subscriptionOutbound.SubscriptionDeliveryRepository,
+	client *adapterOutbound.WebhookClient,
+) *SubscriptionWebhookEventPublisher {
+	return &SubscriptionWebhookEventPublisher{
+		uowFactory:            uowFactory,
+		readRepositoryFactory: readRepositoryFactory,
+		deliveryRepository:    deliveryRepository,
+		client:                client,
+	}
+}
+
+func (p *SubscriptionWebhookEventPublisher) Publish(ctx context.Context, msg *outbound.OutboxMessage) error {
+	uow := p.uowFactory.Create()
+	readRepo := p.readRepositoryFactory.Create(uow)
+
+	subscriptions, err := readRepo.FindMatching(ctx, msg.EventType)
+	if err != nil {
+		return fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	delivered, err := p.deliveryRepository.FindDelivered(ctx, msg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find deliveries: %w", err)
+	}
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		if slices.Contains(delivered, subscription.ID) {
+			continue
+		}
+
+		deliveryErr := p.client.Deliver(ctx, adapterOutbound.WebhookSubscriber{
+			URL:    subscription.URL,
+			Secret: subscription.Secret,
+		}, msg)
+		if deliveryErr != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", subscription.ID, deliveryErr))
+		}
+
+		if err := p.deliveryRepository.RecordAttempt(ctx, subscription.ID, msg.ID, msg.EventType, deliveryErr); err != nil {
+			// Without a record the subscription is retried with the message,
+			// which the idempotency key makes safe.
+			errs = append(errs, fmt.Errorf("subscription %s: %w", subscription.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
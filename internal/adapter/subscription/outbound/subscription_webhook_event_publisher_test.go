@@ -0,0 +1,197 @@
+package outbound
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	adapterOutbound "use-open-workflow.io/engine/internal/adapter/outbound"
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+	"use-open-workflow.io/engine/internal/port/outbound"
+	subscriptionOutbound "use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+type fakeUnitOfWork struct {
+	outbound.UnitOfWork
+}
+
+type fakeUnitOfWorkFactory struct{}
+
+func (fakeUnitOfWorkFactory) Create() outbound.UnitOfWork { return fakeUnitOfWork{} }
+
+// fakeSubscriptionReadRepository matches subscriptions in memory.
+type fakeSubscriptionReadRepository struct {
+	subscriptions []*aggregate.Subscription
+}
+
+func (r *fakeSubscriptionReadRepository) Create(outbound.UnitOfWork) subscriptionOutbound.SubscriptionReadRepository {
+	return r
+}
+
+func (r *fakeSubscriptionReadRepository) FindMany(ctx context.Context) ([]*aggregate.Subscription, error) {
+	return r.subscriptions, nil
+}
+
+func (r *fakeSubscriptionReadRepository) FindByID(ctx context.Context, id string) (*aggregate.Subscription, error) {
+	for _, s := range r.subscriptions {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeSubscriptionReadRepository) FindMatching(ctx context.Context, eventType string) ([]*aggregate.Subscription, error) {
+	var matching []*aggregate.Subscription
+	for _, s := range r.subscriptions {
+		if s.Matches(eventType) {
+			matching = append(matching, s)
+		}
+	}
+	return matching, nil
+}
+
+type fakeSubscriptionDeliveryRepository struct {
+	deliveries map[string]*subscriptionOutbound.SubscriptionDelivery
+}
+
+func newFakeSubscriptionDeliveryRepository() *fakeSubscriptionDeliveryRepository {
+	return &fakeSubscriptionDeliveryRepository{deliveries: map[string]*subscriptionOutbound.SubscriptionDelivery{}}
+}
+
+func (r *fakeSubscriptionDeliveryRepository) FindDelivered(ctx context.Context, outboxID string) ([]string, error) {
+	var ids []string
+	for _, d := range r.deliveries {
+		if d.OutboxID == outboxID && d.Status == subscriptionOutbound.SubscriptionDeliveryStatusDelivered {
+			ids = append(ids, d.SubscriptionID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeSubscriptionDeliveryRepository) RecordAttempt(ctx context.Context, subscriptionID, outboxID, eventType string, deliveryErr error) error {
+	key := subscriptionID + "/" + outboxID
+	d, ok := r.deliveries[key]
+	if !ok {
+		d = &subscriptionOutbound.SubscriptionDelivery{SubscriptionID: subscriptionID, OutboxID: outboxID, EventType: eventType}
+		r.deliveries[key] = d
+	}
+	d.Attempts++
+	d.Status = subscriptionOutbound.SubscriptionDeliveryStatusDelivered
+	d.LastError = ""
+	if deliveryErr != nil {
+		d.Status = subscriptionOutbound.SubscriptionDeliveryStatusFailed
+		d.LastError = deliveryErr.Error()
+	}
+	return nil
+}
+
+func (r *fakeSubscriptionDeliveryRepository) FindBySubscription(ctx context.Context, subscriptionID string, limit int) ([]*subscriptionOutbound.SubscriptionDelivery, error) {
+	return nil, nil
+}
+
+// countingServer counts requests and answers with the current status.
+type countingServer struct {
+	*httptest.Server
+	mu     sync.Mutex
+	hits   int
+	status int
+}
+
+func newCountingServer(t *testing.T, status int) *countingServer {
+	s := &countingServer{status: status}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.hits++
+		w.WriteHeader(s.status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *countingServer) setStatus(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+func (s *countingServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hits
+}
+
+func TestSubscriptionWebhookEventPublisher_FansOutToMatchingSubscriptions(t *testing.T) {
+	now := time.Now()
+	all := newCountingServer(t, http.StatusOK)
+	created := newCountingServer(t, http.StatusOK)
+	updated := newCountingServer(t, http.StatusOK)
+	disabled := newCountingServer(t, http.StatusOK)
+
+	readRepository := &fakeSubscriptionReadRepository{subscriptions: []*aggregate.Subscription{
+		aggregate.ReconstituteSubscription("all", all.URL, nil, "", true, now, now),
+		aggregate.ReconstituteSubscription("created", created.URL, []string{"CreateNodeTemplate"}, "secret", true, now, now),
+		aggregate.ReconstituteSubscription("updated", updated.URL, []string{"UpdateNodeTemplate"}, "", true, now, now),
+		aggregate.ReconstituteSubscription("disabled", disabled.URL, nil, "", false, now, now),
+	}}
+	deliveryRepository := newFakeSubscriptionDeliveryRepository()
+	publisher := NewSubscriptionWebhookEventPublisher(fakeUnitOfWorkFactory{}, readRepository, deliveryRepository, adapterOutbound.NewWebhookClient())
+
+	msg := &outbound.OutboxMessage{ID: "msg-1", EventType: "CreateNodeTemplate", Payload: []byte(`{}`)}
+	if err := publisher.Publish(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for name, server := range map[string]*countingServer{"all": all, "created": created, "updated": updated, "disabled": disabled} {
+		want := 0
+		if name == "all" || name == "created" {
+			want = 1
+		}
+		if got := server.count(); got != want {
+			t.Errorf("Expected %d deliveries to %s, got %d", want, name, got)
+		}
+	}
+	if len(deliveryRepository.deliveries) != 2 {
+		t.Errorf("Expected 2 recorded deliveries, got %d", len(deliveryRepository.deliveries))
+	}
+}
+
+func TestSubscriptionWebhookEventPublisher_RetriesOnlyFailedSubscriptions(t *testing.T) {
+	now := time.Now()
+	healthy := newCountingServer(t, http.StatusOK)
+	flaky := newCountingServer(t, http.StatusBadGateway)
+
+	readRepository := &fakeSubscriptionReadRepository{subscriptions: []*aggregate.Subscription{
+		aggregate.ReconstituteSubscription("healthy", healthy.URL, nil, "", true, now, now),
+		aggregate.ReconstituteSubscription("flaky", flaky.URL, nil, "", true, now, now),
+	}}
+	deliveryRepository := newFakeSubscriptionDeliveryRepository()
+	publisher := NewSubscriptionWebhookEventPublisher(fakeUnitOfWorkFactory{}, readRepository, deliveryRepository, adapterOutbound.NewWebhookClient())
+	msg := &outbound.OutboxMessage{ID: "msg-1", EventType: "CreateNodeTemplate", Payload: []byte(`{}`)}
+
+	if err := publisher.Publish(context.Background(), msg); err == nil {
+		t.Fatal("Expected error while a subscription fails")
+	}
+	if d := deliveryRepository.deliveries["flaky/msg-1"]; d == nil || d.Status != subscriptionOutbound.SubscriptionDeliveryStatusFailed || d.LastError == "" {
+		t.Errorf("Expected failed delivery with error for flaky, got %+v", d)
+	}
+
+	flaky.setStatus(http.StatusOK)
+	if err := publisher.Publish(context.Background(), msg); err != nil {
+		t.Fatalf("Expected retry to succeed, got %v", err)
+	}
+
+	if got := healthy.count(); got != 1 {
+		t.Errorf("Expected healthy subscription to receive the message once, got %d", got)
+	}
+	if got := flaky.count(); got != 2 {
+		t.Errorf("Expected flaky subscription to be retried, got %d requests", got)
+	}
+	if d := deliveryRepository.deliveries["flaky/msg-1"]; d.Status != subscriptionOutbound.SubscriptionDeliveryStatusDelivered || d.Attempts != 2 {
+		t.Errorf("Expected delivered after 2 attempts, got %+v", d)
+	}
+}
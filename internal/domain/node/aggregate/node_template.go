@@ -8,24 +8,32 @@ import (
 	"use-open-workflow.io/engine/pkg/id"
 )
 
+// NodeTemplate is a configured node. Type names the executor that runs it,
+// e.g. "http.request"; it is fixed once the template is created.
 type NodeTemplate struct {
 	domain.BaseAggregate
-	Name string
+	Name      string
+	Type      string
+	RateLimit *RateLimit
 }
 
-func newNodeTemplate(idFactory id.Factory, aggregateID string, name string) *NodeTemplate {
+func newNodeTemplate(idFactory id.Factory, aggregateID string, name string, nodeType string, rateLimit *RateLimit) *NodeTemplate {
 	nodeTemplate := &NodeTemplate{
 		BaseAggregate: domain.NewBaseAggregate(aggregateID),
 		Name:          name,
+		Type:          nodeType,
+		RateLimit:     rateLimit,
 	}
-	nodeTemplate.AddEvent(event.NewCreateNodeTemplate(idFactory, nodeTemplate.ID, name))
+	nodeTemplate.AddEvent(event.NewCreateNodeTemplate(idFactory, nodeTemplate.ID, name, nodeType, toEventRateLimit(rateLimit)))
 	return nodeTemplate
 }
 
-func ReconstituteNodeTemplate(aggregateID string, name string, createdAt time.Time, updatedAt time.Time) *NodeTemplate {
+func ReconstituteNodeTemplate(aggregateID string, name string, nodeType string, rateLimit *RateLimit, createdAt time.Time, updatedAt time.Time) *NodeTemplate {
 	return &NodeTemplate{
 		BaseAggregate: domain.ReconstituteBaseAggregate(aggregateID, createdAt, updatedAt),
 		Name:          name,
+		Type:          nodeType,
+		RateLimit:     rateLimit,
 	}
 }
 
@@ -34,3 +42,28 @@ func (n *NodeTemplate) UpdateName(idFactory id.Factory, name string) {
 	n.SetUpdatedAt(time.Now().UTC())
 	n.AddEvent(event.NewUpdateNodeTemplate(idFactory, n.ID, name))
 }
+
+// UpdateRateLimit does nothing when rateLimit equals the current limit.
+func (n *NodeTemplate) UpdateRateLimit(idFactory id.Factory, rateLimit *RateLimit) {
+	if n.RateLimit.Equal(rateLimit) {
+		return
+	}
+	n.RateLimit = rateLimit
+	n.SetUpdatedAt(time.Now().UTC())
+	n.AddEvent(event.NewUpdateNodeTemplateRateLimit(idFactory, n.ID, toEventRateLimit(rateLimit)))
+}
+
+// RateLimitKey identifies the shared token bucket for this template.
+func (n *NodeTemplate) RateLimitKey() string {
+	return "node_template:" + n.ID
+}
+
+func toEventRateLimit(rateLimit *RateLimit) *event.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	return &event.RateLimit{
+		Rate:  rateLimit.Rate,
+		Burst: rateLimit.Burst,
+	}
+}
@@ -14,6 +14,6 @@ func NewNodeTemplateFactory(idFactory id.Factory) *NodeTemplateFactory {
 	}
 }
 
-func (s *NodeTemplateFactory) Make(name string) *NodeTemplate {
-	return newNodeTemplate(s.idFactory, s.idFactory.New(), name)
+func (s *NodeTemplateFactory) Make(name string, nodeType string, rateLimit *RateLimit) *NodeTemplate {
+	return newNodeTemplate(s.idFactory, s.idFactory.New(), name, nodeType, rateLimit)
 }
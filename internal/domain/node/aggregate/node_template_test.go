@@ -19,7 +19,7 @@ func TestNewNodeTemplate_HasTimestamps(t *testing.T) {
 	factory := &mockIDFactory{}
 	before := time.Now().UTC()
 
-	template := newNodeTemplate(factory, "agg-id", "Test Template")
+	template := newNodeTemplate(factory, "agg-id", "Test Template", "http.request", nil)
 
 	after := time.Now().UTC()
 
@@ -38,7 +38,7 @@ func TestReconstituteNodeTemplate_PreservesTimestamps(t *testing.T) {
 	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2024, 6, 15, 18, 30, 0, 0, time.UTC)
 
-	template := ReconstituteNodeTemplate("agg-id", "Test Template", createdAt, updatedAt)
+	template := ReconstituteNodeTemplate("agg-id", "Test Template", "http.request", nil, createdAt, updatedAt)
 
 	if !template.CreatedAt.Equal(createdAt) {
 		t.Errorf("CreatedAt should be %v, got %v", createdAt, template.CreatedAt)
@@ -53,7 +53,7 @@ func TestUpdateName_UpdatesTimestamp(t *testing.T) {
 	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 
-	template := ReconstituteNodeTemplate("agg-id", "Original Name", createdAt, updatedAt)
+	template := ReconstituteNodeTemplate("agg-id", "Original Name", "http.request", nil, createdAt, updatedAt)
 
 	before := time.Now().UTC()
 	template.UpdateName(factory, "New Name")
@@ -69,7 +69,7 @@ func TestUpdateName_PreservesCreatedAt(t *testing.T) {
 	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 
-	template := ReconstituteNodeTemplate("agg-id", "Original Name", createdAt, updatedAt)
+	template := ReconstituteNodeTemplate("agg-id", "Original Name", "http.request", nil, createdAt, updatedAt)
 
 	template.UpdateName(factory, "New Name")
 
@@ -77,3 +77,63 @@ func TestUpdateName_PreservesCreatedAt(t *testing.T) {
 		t.Errorf("CreatedAt should remain %v, got %v", createdAt, template.CreatedAt)
 	}
 }
+
+func TestNewRateLimit_RejectsInvalidValues(t *testing.T) {
+	if _, err := NewRateLimit(0, 1); err == nil {
+		t.Error("Expected error for zero rate")
+	}
+	if _, err := NewRateLimit(10, 0); err == nil {
+		t.Error("Expected error for zero burst")
+	}
+
+	rateLimit, err := NewRateLimit(10, 20)
+	if err != nil {
+		t.Fatalf("Expected valid rate limit, got %v", err)
+	}
+	if rateLimit.Rate != 10 || rateLimit.Burst != 20 {
+		t.Errorf("Expected rate 10 and burst 20, got %v and %d", rateLimit.Rate, rateLimit.Burst)
+	}
+}
+
+func TestUpdateRateLimit_SetsRateLimitAndAddsEvent(t *testing.T) {
+	factory := &mockIDFactory{}
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	template := ReconstituteNodeTemplate("agg-id", "Name", "http.request", nil, createdAt, createdAt)
+	template.UpdateRateLimit(factory, &RateLimit{Rate: 10, Burst: 10})
+
+	if template.RateLimit == nil || template.RateLimit.Rate != 10 {
+		t.Errorf("RateLimit should be updated, got %v", template.RateLimit)
+	}
+	if len(template.Events()) != 1 || template.Events()[0].EventType() != "UpdateNodeTemplateRateLimit" {
+		t.Errorf("Expected a single UpdateNodeTemplateRateLimit event, got %v", template.Events())
+	}
+}
+
+func TestUpdateRateLimit_UnchangedAddsNoEvent(t *testing.T) {
+	factory := &mockIDFactory{}
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		current *RateLimit
+		update  *RateLimit
+	}{
+		{"unlimited", nil, nil},
+		{"same limit", &RateLimit{Rate: 10, Burst: 10}, &RateLimit{Rate: 10, Burst: 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := ReconstituteNodeTemplate("agg-id", "Name", "http.request", tt.current, createdAt, createdAt)
+			template.UpdateRateLimit(factory, tt.update)
+
+			if len(template.Events()) != 0 {
+				t.Errorf("Expected no events, got %v", template.Events())
+			}
+			if !template.UpdatedAt.Equal(createdAt) {
+				t.Errorf("UpdatedAt should be unchanged, got %v", template.UpdatedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package aggregate
+
+import "fmt"
+
+// RateLimit is a token bucket declaration: Rate tokens are added per second,
+// up to Burst tokens.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+func NewRateLimit(rate float64, burst int) (*RateLimit, error) {
+	if rate <= 0 {
+		return nil, fmt.Errorf("rate limit rate must be positive, got %v", rate)
+	}
+	if burst < 1 {
+		return nil, fmt.Errorf("rate limit burst must be at least 1, got %d", burst)
+	}
+	return &RateLimit{
+		Rate:  rate,
+		Burst: burst,
+	}, nil
+}
+
+// Equal reports whether r and other declare the same limit. Either may be
+// nil, meaning unlimited.
+func (r *RateLimit) Equal(other *RateLimit) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	return *r == *other
+}
@@ -7,11 +7,13 @@ import (
 
 type CreateNodeTemplate struct {
 	domain.BaseEvent
-	NodeTemplateID string `json:"node_template_id"`
-	Name           string `json:"name"`
+	NodeTemplateID string     `json:"node_template_id"`
+	Name           string     `json:"name"`
+	NodeType       string     `json:"node_type"`
+	RateLimit      *RateLimit `json:"rate_limit"`
 }
 
-func NewCreateNodeTemplate(idFactory id.Factory, nodeTemplateID, name string) *CreateNodeTemplate {
+func NewCreateNodeTemplate(idFactory id.Factory, nodeTemplateID, name, nodeType string, rateLimit *RateLimit) *CreateNodeTemplate {
 	return &CreateNodeTemplate{
 		BaseEvent: domain.NewBaseEvent(
 			idFactory.New(),
@@ -21,5 +23,7 @@ func NewCreateNodeTemplate(idFactory id.Factory, nodeTemplateID, name string) *C
 		),
 		NodeTemplateID: nodeTemplateID,
 		Name:           name,
+		NodeType:       nodeType,
+		RateLimit:      rateLimit,
 	}
 }
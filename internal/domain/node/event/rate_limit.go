@@ -0,0 +1,6 @@
+package event
+
+type RateLimit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
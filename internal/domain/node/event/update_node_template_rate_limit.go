@@ -0,0 +1,25 @@
+package event
+
+import (
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type UpdateNodeTemplateRateLimit struct {
+	domain.BaseEvent
+	NodeTemplateID string     `json:"node_template_id"`
+	RateLimit      *RateLimit `json:"rate_limit"`
+}
+
+func NewUpdateNodeTemplateRateLimit(idFactory id.Factory, nodeTemplateID string, rateLimit *RateLimit) *UpdateNodeTemplateRateLimit {
+	return &UpdateNodeTemplateRateLimit{
+		BaseEvent: domain.NewBaseEvent(
+			idFactory.New(),
+			nodeTemplateID,
+			"NodeTemplate",
+			"UpdateNodeTemplateRateLimit",
+		),
+		NodeTemplateID: nodeTemplateID,
+		RateLimit:      rateLimit,
+	}
+}
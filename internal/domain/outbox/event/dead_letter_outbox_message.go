@@ -0,0 +1,39 @@
+package event
+
+import (
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+// DeadLetterOutboxMessageEventType is excluded from dead-letter events of
+// its own, so a failing consumer of these events cannot cause a chain.
+const DeadLetterOutboxMessageEventType = "DeadLetterOutboxMessage"
+
+type DeadLetterOutboxMessage struct {
+	domain.BaseEvent
+	// OutboxID is also the event's aggregate ID; the Message fields describe
+	// the event that could not be delivered.
+	OutboxID             string `json:"outbox_id"`
+	MessageAggregateID   string `json:"message_aggregate_id"`
+	MessageAggregateType string `json:"message_aggregate_type"`
+	MessageEventType     string `json:"message_event_type"`
+	Attempts             int    `json:"attempts"`
+	LastError            string `json:"last_error"`
+}
+
+func NewDeadLetterOutboxMessage(idFactory id.Factory, outboxID, aggregateID, aggregateType, eventType string, attempts int, lastError string) *DeadLetterOutboxMessage {
+	return &DeadLetterOutboxMessage{
+		BaseEvent: domain.NewBaseEvent(
+			idFactory.New(),
+			outboxID,
+			"OutboxMessage",
+			DeadLetterOutboxMessageEventType,
+		),
+		OutboxID:             outboxID,
+		MessageAggregateID:   aggregateID,
+		MessageAggregateType: aggregateType,
+		MessageEventType:     eventType,
+		Attempts:             attempts,
+		LastError:            lastError,
+	}
+}
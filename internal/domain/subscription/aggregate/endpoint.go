@@ -0,0 +1,25 @@
+package aggregate
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Endpoint is the absolute http(s) URL events are delivered to.
+type Endpoint struct {
+	URL string
+}
+
+func NewEndpoint(rawURL string) (*Endpoint, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("url scheme must be http or https, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("url must have a host")
+	}
+	return &Endpoint{URL: rawURL}, nil
+}
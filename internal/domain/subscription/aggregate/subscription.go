@@ -0,0 +1,78 @@
+package aggregate
+
+import (
+	"slices"
+	"time"
+
+	"use-open-workflow.io/engine/internal/domain/subscription/event"
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+// Subscription is a webhook endpoint that receives outbox events. An empty
+// EventTypes list matches every event type.
+type Subscription struct {
+	domain.BaseAggregate
+	URL        string
+	EventTypes []string
+	Secret     string
+	Enabled    bool
+}
+
+func newSubscription(idFactory id.Factory, aggregateID string, endpoint *Endpoint, eventTypes []string, secret string, enabled bool) *Subscription {
+	subscription := &Subscription{
+		BaseAggregate: domain.NewBaseAggregate(aggregateID),
+		URL:           endpoint.URL,
+		EventTypes:    eventTypes,
+		Secret:        secret,
+		Enabled:       enabled,
+	}
+	subscription.AddEvent(event.NewCreateSubscription(idFactory, subscription.ID, endpoint.URL, eventTypes, enabled))
+	return subscription
+}
+
+func ReconstituteSubscription(aggregateID string, url string, eventTypes []string, secret string, enabled bool, createdAt time.Time, updatedAt time.Time) *Subscription {
+	return &Subscription{
+		BaseAggregate: domain.ReconstituteBaseAggregate(aggregateID, createdAt, updatedAt),
+		URL:           url,
+		EventTypes:    eventTypes,
+		Secret:        secret,
+		Enabled:       enabled,
+	}
+}
+
+func (s *Subscription) Update(idFactory id.Factory, endpoint *Endpoint, eventTypes []string) {
+	s.URL = endpoint.URL
+	s.EventTypes = eventTypes
+	s.SetUpdatedAt(time.Now().UTC())
+	s.AddEvent(event.NewUpdateSubscription(idFactory, s.ID, endpoint.URL, eventTypes))
+}
+
+func (s *Subscription) RotateSecret(idFactory id.Factory, secret string) {
+	s.Secret = secret
+	s.SetUpdatedAt(time.Now().UTC())
+	s.AddEvent(event.NewRotateSubscriptionSecret(idFactory, s.ID))
+}
+
+func (s *Subscription) Enable(idFactory id.Factory) {
+	if s.Enabled {
+		return
+	}
+	s.Enabled = true
+	s.SetUpdatedAt(time.Now().UTC())
+	s.AddEvent(event.NewEnableSubscription(idFactory, s.ID))
+}
+
+func (s *Subscription) Disable(idFactory id.Factory) {
+	if !s.Enabled {
+		return
+	}
+	s.Enabled = false
+	s.SetUpdatedAt(time.Now().UTC())
+	s.AddEvent(event.NewDisableSubscription(idFactory, s.ID))
+}
+
+// Matches reports whether the subscription should receive eventType.
+func (s *Subscription) Matches(eventType string) bool {
+	return s.Enabled && (len(s.EventTypes) == 0 || slices.Contains(s.EventTypes, eventType))
+}
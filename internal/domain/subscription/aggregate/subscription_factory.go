@@ -0,0 +1,19 @@
+package aggregate
+
+import (
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type SubscriptionFactory struct {
+	idFactory id.Factory
+}
+
+func NewSubscriptionFactory(idFactory id.Factory) *SubscriptionFactory {
+	return &SubscriptionFactory{
+		idFactory: idFactory,
+	}
+}
+
+func (s *SubscriptionFactory) Make(endpoint *Endpoint, eventTypes []string, secret string, enabled bool) *Subscription {
+	return newSubscription(s.idFactory, s.idFactory.New(), endpoint, eventTypes, secret, enabled)
+}
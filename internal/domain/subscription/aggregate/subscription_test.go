@@ -0,0 +1,99 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type mockIDFactory struct{}
+
+func (m *mockIDFactory) New() string {
+	return "mock-id"
+}
+
+var _ id.Factory = (*mockIDFactory)(nil)
+
+func TestNewEndpoint(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://example.com/hooks", false},
+		{"http://localhost:8080", false},
+		{"ftp://example.com", true},
+		{"example.com/hooks", true},
+		{"https://", true},
+	}
+
+	for _, tt := range tests {
+		_, err := NewEndpoint(tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewEndpoint(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSubscription_Matches(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	all := ReconstituteSubscription("agg-id", "https://example.com", nil, "", true, createdAt, createdAt)
+	if !all.Matches("CreateNodeTemplate") {
+		t.Error("Subscription without event types should match every event")
+	}
+
+	filtered := ReconstituteSubscription("agg-id", "https://example.com", []string{"CreateNodeTemplate"}, "", true, createdAt, createdAt)
+	if !filtered.Matches("CreateNodeTemplate") {
+		t.Error("Subscription should match a listed event type")
+	}
+	if filtered.Matches("UpdateNodeTemplate") {
+		t.Error("Subscription should not match an unlisted event type")
+	}
+
+	disabled := ReconstituteSubscription("agg-id", "https://example.com", nil, "", false, createdAt, createdAt)
+	if disabled.Matches("CreateNodeTemplate") {
+		t.Error("Disabled subscription should not match")
+	}
+}
+
+func TestSubscription_EnableDisableAddEventsOnChange(t *testing.T) {
+	factory := &mockIDFactory{}
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	subscription := ReconstituteSubscription("agg-id", "https://example.com", nil, "", true, createdAt, createdAt)
+
+	subscription.Enable(factory)
+	if len(subscription.Events()) != 0 {
+		t.Errorf("Enabling an enabled subscription should not add events, got %d", len(subscription.Events()))
+	}
+
+	subscription.Disable(factory)
+	if subscription.Enabled {
+		t.Error("Subscription should be disabled")
+	}
+	if !subscription.UpdatedAt.After(createdAt) {
+		t.Errorf("UpdatedAt should be after %v, got %v", createdAt, subscription.UpdatedAt)
+	}
+	events := subscription.Events()
+	if len(events) != 1 || events[0].EventType() != "DisableSubscription" {
+		t.Errorf("Expected one DisableSubscription event, got %v", events)
+	}
+}
+
+func TestSubscriptionFactory_Make(t *testing.T) {
+	endpoint, err := NewEndpoint("https://example.com/hooks")
+	if err != nil {
+		t.Fatalf("Expected valid endpoint, got %v", err)
+	}
+
+	subscription := NewSubscriptionFactory(&mockIDFactory{}).Make(endpoint, []string{"CreateNodeTemplate"}, "secret", true)
+
+	if subscription.URL != "https://example.com/hooks" || subscription.Secret != "secret" || !subscription.Enabled {
+		t.Errorf("Unexpected subscription %+v", subscription)
+	}
+	events := subscription.Events()
+	if len(events) != 1 || events[0].EventType() != "CreateSubscription" {
+		t.Errorf("Expected one CreateSubscription event, got %v", events)
+	}
+}
@@ -0,0 +1,29 @@
+package event
+
+import (
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type CreateSubscription struct {
+	domain.BaseEvent
+	SubscriptionID string   `json:"subscription_id"`
+	URL            string   `json:"url"`
+	EventTypes     []string `json:"event_types"`
+	Enabled        bool     `json:"enabled"`
+}
+
+func NewCreateSubscription(idFactory id.Factory, subscriptionID string, url string, eventTypes []string, enabled bool) *CreateSubscription {
+	return &CreateSubscription{
+		BaseEvent: domain.NewBaseEvent(
+			idFactory.New(),
+			subscriptionID,
+			"Subscription",
+			"CreateSubscription",
+		),
+		SubscriptionID: subscriptionID,
+		URL:            url,
+		EventTypes:     eventTypes,
+		Enabled:        enabled,
+	}
+}
@@ -0,0 +1,23 @@
+package event
+
+import (
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type DisableSubscription struct {
+	domain.BaseEvent
+	SubscriptionID string `json:"subscription_id"`
+}
+
+func NewDisableSubscription(idFactory id.Factory, subscriptionID string) *DisableSubscription {
+	return &DisableSubscription{
+		BaseEvent: domain.NewBaseEvent(
+			idFactory.New(),
+			subscriptionID,
+			"Subscription",
+			"DisableSubscription",
+		),
+		SubscriptionID: subscriptionID,
+	}
+}
@@ -0,0 +1,23 @@
+package event
+
+import (
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type EnableSubscription struct {
+	domain.BaseEvent
+	SubscriptionID string `json:"subscription_id"`
+}
+
+func NewEnableSubscription(idFactory id.Factory, subscriptionID string) *EnableSubscription {
+	return &EnableSubscription{
+		BaseEvent: domain.NewBaseEvent(
+			idFactory.New(),
+			subscriptionID,
+			"Subscription",
+			"EnableSubscription",
+		),
+		SubscriptionID: subscriptionID,
+	}
+}
@@ -0,0 +1,23 @@
+package event
+
+import (
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type RotateSubscriptionSecret struct {
+	domain.BaseEvent
+	SubscriptionID string `json:"subscription_id"`
+}
+
+func NewRotateSubscriptionSecret(idFactory id.Factory, subscriptionID string) *RotateSubscriptionSecret {
+	return &RotateSubscriptionSecret{
+		BaseEvent: domain.NewBaseEvent(
+			idFactory.New(),
+			subscriptionID,
+			"Subscription",
+			"RotateSubscriptionSecret",
+		),
+		SubscriptionID: subscriptionID,
+	}
+}
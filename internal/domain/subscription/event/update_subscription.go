@@ -0,0 +1,27 @@
+package event
+
+import (
+	"use-open-workflow.io/engine/pkg/domain"
+	"use-open-workflow.io/engine/pkg/id"
+)
+
+type UpdateSubscription struct {
+	domain.BaseEvent
+	SubscriptionID string   `json:"subscription_id"`
+	URL            string   `json:"url"`
+	EventTypes     []string `json:"event_types"`
+}
+
+func NewUpdateSubscription(idFactory id.Factory, subscriptionID string, url string, eventTypes []string) *UpdateSubscription {
+	return &UpdateSubscription{
+		BaseEvent: domain.NewBaseEvent(
+			idFactory.New(),
+			subscriptionID,
+			"Subscription",
+			"UpdateSubscription",
+		),
+		SubscriptionID: subscriptionID,
+		URL:            url,
+		EventTypes:     eventTypes,
+	}
+}
@@ -3,8 +3,15 @@ package inbound
 import "time"
 
 type NodeTemplateDTO struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	Type      string        `json:"type"`
+	RateLimit *RateLimitDTO `json:"rateLimit"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+type RateLimitDTO struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
 }
@@ -4,4 +4,5 @@ import "use-open-workflow.io/engine/internal/domain/node/aggregate"
 
 type NodeTemplateMapper interface {
 	To(*aggregate.NodeTemplate) (*NodeTemplateDTO, error)
+	RateLimitFrom(*RateLimitDTO) (*aggregate.RateLimit, error)
 }
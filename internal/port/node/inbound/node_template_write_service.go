@@ -2,12 +2,16 @@ package inbound
 
 import "context"
 
+// CreateNodeTemplateInput.Type cannot be changed later.
 type CreateNodeTemplateInput struct {
-	Name string `json:"name"`
+	Name      string        `json:"name"`
+	Type      string        `json:"type"`
+	RateLimit *RateLimitDTO `json:"rateLimit"`
 }
 
 type UpdateNodeTemplateInput struct {
-	Name string `json:"name"`
+	Name      string        `json:"name"`
+	RateLimit *RateLimitDTO `json:"rateLimit"`
 }
 
 type NodeTemplateWriteService interface {
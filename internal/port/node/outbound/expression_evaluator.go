@@ -0,0 +1,16 @@
+package outbound
+
+// ExpressionEvaluator evaluates a workflow expression against scope, e.g.
+// "item.price * 2" with scope {"item": {...}}.
+type ExpressionEvaluator interface {
+	Evaluate(expression string, scope map[string]any) (any, error)
+	// Bind prepares scope once for evaluating many expressions against it,
+	// e.g. the node input shared by every item of an array.
+	Bind(scope map[string]any) (BoundExpressionEvaluator, error)
+}
+
+// BoundExpressionEvaluator evaluates expressions against a bound scope plus
+// the entries of scope, which win over bound entries of the same name.
+type BoundExpressionEvaluator interface {
+	Evaluate(expression string, scope map[string]any) (any, error)
+}
@@ -0,0 +1,9 @@
+package outbound
+
+// NodeBinaryData is how binary outputs (files, images, ...) are passed
+// between steps. Data is base64 encoded in JSON.
+type NodeBinaryData struct {
+	FileName string `json:"fileName"`
+	MimeType string `json:"mimeType"`
+	Data     []byte `json:"data"`
+}
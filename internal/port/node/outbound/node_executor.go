@@ -0,0 +1,54 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// NodeExecutor runs a single node. Config is the node's configuration with
+// expressions already resolved, Input the data handed over by upstream steps.
+type NodeExecutor interface {
+	Execute(ctx context.Context, request *NodeExecutionRequest) (*NodeExecutionResult, error)
+}
+
+type NodeExecutionRequest struct {
+	Config     json.RawMessage
+	Input      any
+	Credential *NodeCredential
+}
+
+// NodeCredential is a decrypted credential attached to a node. Data keys
+// depend on Type, e.g. "token" for bearer or "username"/"password" for basic.
+type NodeCredential struct {
+	Type string
+	Data map[string]string
+}
+
+type NodeExecutionResult struct {
+	Output any
+}
+
+// NodeExecutionError tells the engine whether a failed execution may be retried.
+type NodeExecutionError struct {
+	Err       error
+	Retryable bool
+}
+
+func NewRetryableNodeExecutionError(err error) *NodeExecutionError {
+	return &NodeExecutionError{Err: err, Retryable: true}
+}
+
+func NewPermanentNodeExecutionError(err error) *NodeExecutionError {
+	return &NodeExecutionError{Err: err, Retryable: false}
+}
+
+func (e *NodeExecutionError) Error() string { return e.Err.Error() }
+func (e *NodeExecutionError) Unwrap() error { return e.Err }
+
+// IsRetryableNodeExecutionError reports whether err, or an error it wraps, is
+// a retryable NodeExecutionError.
+func IsRetryableNodeExecutionError(err error) bool {
+	var executionErr *NodeExecutionError
+	return errors.As(err, &executionErr) && executionErr.Retryable
+}
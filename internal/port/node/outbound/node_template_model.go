@@ -5,10 +5,17 @@ import "time"
 type NodeTemplateModel struct {
 	ID        string
 	Name      string
+	Type      string
+	RateLimit *RateLimitModel
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+type RateLimitModel struct {
+	Rate  float64
+	Burst int
+}
+
 func NewNodeTemplateModel() *NodeTemplateModel {
 	return &NodeTemplateModel{}
 }
@@ -11,4 +11,8 @@ type OutboxMessage struct {
 	CreatedAt     time.Time
 	ProcessedAt   *time.Time
 	RetryCount    int
+	NextAttemptAt time.Time
+	LastError     string
+	// DeadLetteredAt is set once delivery was given up on.
+	DeadLetteredAt *time.Time
 }
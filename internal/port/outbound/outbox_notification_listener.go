@@ -0,0 +1,12 @@
+package outbound
+
+import "context"
+
+// OutboxNotificationListener tells the OutboxProcessor that new messages were
+// written, so it does not have to wait for the next poll.
+type OutboxNotificationListener interface {
+	// Listen signals on wake for each notification until ctx is done or the
+	// underlying connection fails. It also signals once when it starts
+	// listening, covering messages written while it was not.
+	Listen(ctx context.Context, wake chan<- struct{}) error
+}
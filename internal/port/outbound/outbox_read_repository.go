@@ -3,5 +3,7 @@ package outbound
 import "context"
 
 type OutboxReadRepository interface {
-	FindUnprocessed(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	// FindDeadLettered returns dead letters, most recent first.
+	FindDeadLettered(ctx context.Context, limit, offset int) ([]*OutboxMessage, error)
+	FindByID(ctx context.Context, id string) (*OutboxMessage, error)
 }
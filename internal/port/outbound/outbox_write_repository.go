@@ -3,10 +3,43 @@ package outbound
 import (
 	"context"
 	"time"
+
+	"use-open-workflow.io/engine/pkg/domain"
 )
 
 type OutboxWriteRepository interface {
-	MarkProcessed(ctx context.Context, id string) error
-	IncrementRetry(ctx context.Context, id string) error
+	// Claim leases up to limit due messages to claimedBy for lease, so other
+	// processors skip them meanwhile, and returns them oldest first. Messages
+	// whose lease ran out without being processed can be claimed again.
+	Claim(ctx context.Context, claimedBy string, lease time.Duration, limit int) ([]*OutboxMessage, error)
+	// ClaimInOrder is Claim without messages queued behind an earlier message
+	// of the same aggregate that is not due yet or claimed by another
+	// processor.
+	ClaimInOrder(ctx context.Context, claimedBy string, lease time.Duration, limit int) ([]*OutboxMessage, error)
+	// MarkProcessed marks all given messages as published in one update.
+	//
+	// MarkProcessed, IncrementRetry, DeadLetter and ReleaseClaims only touch
+	// messages still claimed by claimedBy, so a processor whose lease ran out
+	// cannot overwrite the outcome of the processor that claimed them next.
+	MarkProcessed(ctx context.Context, claimedBy string, ids []string) error
+	// IncrementRetry records a failed attempt, schedules the next one and
+	// releases the claim.
+	IncrementRetry(ctx context.Context, claimedBy string, id string, nextAttemptAt time.Time, lastError string) error
+	// DeadLetter records the final failed attempt and stops retrying the
+	// message. A non-nil event is added to the outbox in the same transaction.
+	DeadLetter(ctx context.Context, claimedBy string, id string, lastError string, event domain.Event) error
+	// ExtendClaims renews the lease on every unfinished message claimed by
+	// claimedBy, so a batch that is still being published is not claimed by
+	// another processor.
+	ExtendClaims(ctx context.Context, claimedBy string, lease time.Duration) error
+	// ReleaseClaims gives up the claims on messages that were not attempted,
+	// so they can be claimed again before the lease runs out.
+	ReleaseClaims(ctx context.Context, claimedBy string, ids []string) error
+	// Requeue moves the given dead letters back into delivery and returns
+	// how many were requeued.
+	Requeue(ctx context.Context, ids []string) (int64, error)
+	// RequeueAll requeues every dead letter, or only those of eventType if
+	// it is not empty.
+	RequeueAll(ctx context.Context, eventType string) (int64, error)
 	DeleteProcessed(ctx context.Context, olderThan time.Duration) error
 }
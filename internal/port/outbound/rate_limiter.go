@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiter hands out tokens from token buckets shared by every process.
+// Reserve always takes a token and returns how long the caller has to wait
+// before using it, so callers can delay work instead of failing it.
+type RateLimiter interface {
+	Reserve(ctx context.Context, key string, rate float64, burst int) (time.Duration, error)
+}
@@ -0,0 +1,30 @@
+package inbound
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type OutboxDeadLetterDTO struct {
+	ID             string          `json:"id"`
+	AggregateID    string          `json:"aggregateId"`
+	AggregateType  string          `json:"aggregateType"`
+	EventType      string          `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"lastError"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	DeadLetteredAt time.Time       `json:"deadLetteredAt"`
+}
+
+// RequeueOutboxDeadLettersInput selects dead letters either by IDs or, with
+// All, every dead letter optionally narrowed to one EventType.
+type RequeueOutboxDeadLettersInput struct {
+	IDs       []string `json:"ids"`
+	All       bool     `json:"all"`
+	EventType string   `json:"eventType"`
+}
+
+type RequeueOutboxDeadLettersResult struct {
+	Requeued int64 `json:"requeued"`
+}
@@ -0,0 +1,16 @@
+package inbound
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNoDeadLettersSelected = errors.New("either ids or all must be set")
+
+type OutboxDeadLetterService interface {
+	List(ctx context.Context, limit, offset int) ([]*OutboxDeadLetterDTO, error)
+	GetByID(ctx context.Context, id string) (*OutboxDeadLetterDTO, error)
+	// Requeue reports false when id is not a dead letter.
+	Requeue(ctx context.Context, id string) (bool, error)
+	RequeueMany(ctx context.Context, input RequeueOutboxDeadLettersInput) (*RequeueOutboxDeadLettersResult, error)
+}
@@ -0,0 +1,25 @@
+package inbound
+
+import "time"
+
+// SubscriptionDTO never carries the secret; HasSecret tells clients whether
+// deliveries are signed.
+type SubscriptionDTO struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	HasSecret  bool      `json:"hasSecret"`
+	Enabled    bool      `json:"enabled"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type SubscriptionDeliveryDTO struct {
+	OutboxID    string     `json:"outboxId"`
+	EventType   string     `json:"eventType"`
+	Status      string     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	LastError   string     `json:"lastError,omitempty"`
+	DeliveredAt *time.Time `json:"deliveredAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
@@ -0,0 +1,12 @@
+package inbound
+
+import (
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+	"use-open-workflow.io/engine/internal/port/subscription/outbound"
+)
+
+type SubscriptionMapper interface {
+	To(*aggregate.Subscription) (*SubscriptionDTO, error)
+	DeliveryTo(*outbound.SubscriptionDelivery) (*SubscriptionDeliveryDTO, error)
+	EndpointFrom(url string) (*aggregate.Endpoint, error)
+}
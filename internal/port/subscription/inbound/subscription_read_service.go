@@ -0,0 +1,9 @@
+package inbound
+
+import "context"
+
+type SubscriptionReadService interface {
+	List(ctx context.Context) ([]*SubscriptionDTO, error)
+	GetByID(ctx context.Context, id string) (*SubscriptionDTO, error)
+	ListDeliveries(ctx context.Context, id string) ([]*SubscriptionDeliveryDTO, error)
+}
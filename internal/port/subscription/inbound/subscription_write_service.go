@@ -0,0 +1,25 @@
+package inbound
+
+import "context"
+
+type CreateSubscriptionInput struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"eventTypes"`
+	Secret     string   `json:"secret"`
+	Enabled    *bool    `json:"enabled"`
+}
+
+// UpdateSubscriptionInput replaces URL and EventTypes. An empty Secret and a
+// nil Enabled leave the current values untouched.
+type UpdateSubscriptionInput struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"eventTypes"`
+	Secret     string   `json:"secret"`
+	Enabled    *bool    `json:"enabled"`
+}
+
+type SubscriptionWriteService interface {
+	Create(ctx context.Context, input CreateSubscriptionInput) (*SubscriptionDTO, error)
+	Update(ctx context.Context, id string, input UpdateSubscriptionInput) (*SubscriptionDTO, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,21 @@
+package outbound
+
+import "time"
+
+const (
+	SubscriptionDeliveryStatusDelivered = "delivered"
+	SubscriptionDeliveryStatusFailed    = "failed"
+)
+
+// SubscriptionDelivery is the delivery state of one outbox message for one
+// subscription.
+type SubscriptionDelivery struct {
+	SubscriptionID string
+	OutboxID       string
+	EventType      string
+	Status         string
+	Attempts       int
+	LastError      string
+	DeliveredAt    *time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,13 @@
+package outbound
+
+import "context"
+
+type SubscriptionDeliveryRepository interface {
+	// FindDelivered returns the IDs of subscriptions that already received
+	// the outbox message.
+	FindDelivered(ctx context.Context, outboxID string) ([]string, error)
+	// RecordAttempt stores the outcome of one delivery attempt; a nil
+	// deliveryErr marks the delivery as done.
+	RecordAttempt(ctx context.Context, subscriptionID, outboxID, eventType string, deliveryErr error) error
+	FindBySubscription(ctx context.Context, subscriptionID string, limit int) ([]*SubscriptionDelivery, error)
+}
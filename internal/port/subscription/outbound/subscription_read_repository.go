@@ -0,0 +1,14 @@
+package outbound
+
+import (
+	"context"
+
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+)
+
+type SubscriptionReadRepository interface {
+	FindMany(ctx context.Context) ([]*aggregate.Subscription, error)
+	FindByID(ctx context.Context, id string) (*aggregate.Subscription, error)
+	// FindMatching returns the enabled subscriptions that receive eventType.
+	FindMatching(ctx context.Context, eventType string) ([]*aggregate.Subscription, error)
+}
@@ -0,0 +1,7 @@
+package outbound
+
+import "use-open-workflow.io/engine/internal/port/outbound"
+
+type SubscriptionReadRepositoryFactory interface {
+	Create(uow outbound.UnitOfWork) SubscriptionReadRepository
+}
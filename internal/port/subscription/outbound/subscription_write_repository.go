@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+
+	"use-open-workflow.io/engine/internal/domain/subscription/aggregate"
+)
+
+type SubscriptionWriteRepository interface {
+	Save(ctx context.Context, subscription *aggregate.Subscription) error
+	Update(ctx context.Context, subscription *aggregate.Subscription) error
+	Delete(ctx context.Context, id string) error
+}
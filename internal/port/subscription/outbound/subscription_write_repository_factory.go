@@ -0,0 +1,7 @@
+package outbound
+
+import "use-open-workflow.io/engine/internal/port/outbound"
+
+type SubscriptionWriteRepositoryFactory interface {
+	Create(uow outbound.UnitOfWork) SubscriptionWriteRepository
+}
@@ -0,0 +1,28 @@
+-- Rate limit declared by a node template (token bucket, NULL = unlimited)
+ALTER TABLE node_template
+    ADD COLUMN IF NOT EXISTS rate_limit_rate DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS rate_limit_burst INTEGER;
+
+-- ADD CONSTRAINT has no IF NOT EXISTS
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1
+        FROM pg_constraint
+        WHERE conname = 'check_rate_limit' AND conrelid = 'node_template'::regclass
+    ) THEN
+        ALTER TABLE node_template
+            ADD CONSTRAINT check_rate_limit CHECK (
+                (rate_limit_rate IS NULL AND rate_limit_burst IS NULL)
+                OR (rate_limit_rate > 0 AND rate_limit_burst >= 1)
+            );
+    END IF;
+END
+$$;
+
+-- Token buckets shared by all workers, keyed by e.g. node_template:<id>
+CREATE TABLE IF NOT EXISTS rate_limit_bucket (
+    key VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,30 @@
+-- Webhook subscriptions for outbox events (empty event_types = all events)
+CREATE TABLE IF NOT EXISTS subscription (
+    id VARCHAR(26) PRIMARY KEY,
+    url TEXT NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    secret TEXT NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_created_at ON subscription(created_at DESC);
+
+-- Delivery state of each outbox message per subscription
+CREATE TABLE IF NOT EXISTS subscription_delivery (
+    subscription_id VARCHAR(26) NOT NULL REFERENCES subscription(id) ON DELETE CASCADE,
+    outbox_id VARCHAR(26) NOT NULL REFERENCES outbox(id) ON DELETE CASCADE,
+    event_type VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (subscription_id, outbox_id),
+    CONSTRAINT check_delivery_status CHECK (status IN ('delivered', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_delivery_outbox ON subscription_delivery(outbox_id);
+CREATE INDEX IF NOT EXISTS idx_subscription_delivery_updated_at ON subscription_delivery(subscription_id, updated_at DESC);
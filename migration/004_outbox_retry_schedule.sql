@@ -0,0 +1,12 @@
+-- Scheduled retries: failed messages wait until next_attempt_at
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS last_error TEXT;
+
+DROP INDEX IF EXISTS idx_outbox_unprocessed;
+CREATE INDEX IF NOT EXISTS idx_outbox_unprocessed ON outbox(created_at ASC, id ASC)
+    WHERE processed_at IS NULL AND retry_count < 5;
+
+-- Lookup of earlier pending messages per aggregate for ordered delivery
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_aggregate ON outbox(aggregate_type, aggregate_id, created_at)
+    WHERE processed_at IS NULL;
@@ -0,0 +1,16 @@
+-- Dead letters: messages that exhausted their attempts stay in the outbox
+-- with dead_lettered_at set until they are requeued
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMP WITH TIME ZONE;
+
+-- Messages the old hard-coded limit of 5 attempts silently dropped
+UPDATE outbox
+SET dead_lettered_at = NOW()
+WHERE processed_at IS NULL AND retry_count >= 5 AND dead_lettered_at IS NULL;
+
+DROP INDEX IF EXISTS idx_outbox_unprocessed;
+CREATE INDEX IF NOT EXISTS idx_outbox_unprocessed ON outbox(created_at ASC, id ASC)
+    WHERE processed_at IS NULL AND dead_lettered_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_dead_lettered ON outbox(dead_lettered_at DESC)
+    WHERE dead_lettered_at IS NOT NULL;
@@ -0,0 +1,5 @@
+-- Claim leases: a processor owns the messages it claimed until claimed_until,
+-- so several processors never publish the same message concurrently
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS claimed_by VARCHAR(26),
+    ADD COLUMN IF NOT EXISTS claimed_until TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,3 @@
+-- Node type of a template, naming the executor that runs it (e.g. http.request)
+ALTER TABLE node_template
+    ADD COLUMN IF NOT EXISTS node_type VARCHAR(100) NOT NULL DEFAULT '';